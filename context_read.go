@@ -80,3 +80,15 @@ func HasReturnOld(ctx context.Context) (interface{}, bool) {
 	}
 	return nil, false
 }
+
+// HasRawResponse is used to fetch the raw response, which is set by WithRawResponse, from the context.
+func HasRawResponse(ctx context.Context) (*[]byte, bool) {
+	if ctx != nil {
+		if q := ctx.Value(keyRawResponse); q != nil {
+			if v, ok := q.(*[]byte); ok {
+				return v, true
+			}
+		}
+	}
+	return nil, false
+}
@@ -59,6 +59,9 @@ type Database interface {
 	// DatabaseArangoSearchAnalyzers - ArangoSearch Analyzers API
 	DatabaseArangoSearchAnalyzers
 
+	// DatabaseQueries - AQL query tracking functions
+	DatabaseQueries
+
 	// Query performs an AQL query, returning a cursor used to iterate over the returned documents.
 	// Note that the returned Cursor must always be closed to avoid holding on to resources in the server while they are no longer needed.
 	Query(ctx context.Context, query string, bindVars map[string]interface{}) (Cursor, error)
@@ -0,0 +1,109 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"time"
+)
+
+// DatabaseQueries provides access to the tracking of AQL queries in a single database.
+type DatabaseQueries interface {
+	// RunningQueries returns the AQL queries which are currently running.
+	RunningQueries(ctx context.Context, opts *QueryTrackingOptions) ([]RunningAQLQuery, error)
+
+	// SlowQueries returns the AQL queries which have exceeded the slow query threshold.
+	SlowQueries(ctx context.Context, opts *QueryTrackingOptions) ([]RunningAQLQuery, error)
+
+	// ClearSlowQueries clears the list of slow AQL queries.
+	ClearSlowQueries(ctx context.Context, opts *QueryTrackingOptions) error
+
+	// KillQuery kills the running AQL query with the given ID.
+	// The query is aborted at the next cancellation point.
+	KillQuery(ctx context.Context, queryID string, opts *QueryTrackingOptions) error
+
+	// QueryProperties returns the configuration of the AQL query tracking.
+	QueryProperties(ctx context.Context) (QueryProperties, error)
+
+	// SetQueryProperties changes the configuration of the AQL query tracking and returns the new configuration.
+	SetQueryProperties(ctx context.Context, options SetQueryPropertiesOptions) (QueryProperties, error)
+}
+
+// QueryTrackingOptions holds optional options for the AQL query tracking functions.
+type QueryTrackingOptions struct {
+	// All if set to true, uses the queries of all databases, not only the ones of the current database.
+	// It can be used only in the _system database and requires superuser rights.
+	All bool
+}
+
+// RunningAQLQuery describes an AQL query which is running or has been slow.
+type RunningAQLQuery struct {
+	// ID of the query.
+	ID string `json:"id"`
+	// Database is the name of the database the query runs in.
+	Database string `json:"database"`
+	// User is the name of the user who started the query.
+	User string `json:"user"`
+	// Query is the query string, possibly truncated to the maximum query string length.
+	Query string `json:"query"`
+	// BindVars holds the bind parameters of the query, if the tracking of bind parameters is enabled.
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+	// Started is the date and time when the query was started.
+	Started time.Time `json:"started"`
+	// RunTime is the run time of the query in seconds.
+	RunTime float64 `json:"runTime"`
+	// PeakMemoryUsage is the peak memory usage of the query in bytes.
+	PeakMemoryUsage uint64 `json:"peakMemoryUsage,omitempty"`
+	// State is the state of the query, e.g. "executing" or "finished" for slow queries.
+	State string `json:"state"`
+	// Stream is true when the query is a streaming query.
+	Stream bool `json:"stream"`
+}
+
+// QueryProperties holds the configuration of the AQL query tracking.
+type QueryProperties struct {
+	// Enabled is true when the currently running and slow queries are tracked.
+	Enabled bool `json:"enabled"`
+	// TrackSlowQueries is true when the slow queries are tracked.
+	TrackSlowQueries bool `json:"trackSlowQueries"`
+	// TrackBindVars is true when the bind parameters of the queries are tracked.
+	TrackBindVars bool `json:"trackBindVars"`
+	// MaxSlowQueries is the maximum number of slow queries to keep in the list.
+	MaxSlowQueries int `json:"maxSlowQueries"`
+	// SlowQueryThreshold is the threshold in seconds above which a query is considered slow.
+	SlowQueryThreshold float64 `json:"slowQueryThreshold"`
+	// SlowStreamingQueryThreshold is the threshold in seconds above which a streaming query is considered slow.
+	SlowStreamingQueryThreshold float64 `json:"slowStreamingQueryThreshold"`
+	// MaxQueryStringLength is the maximum length of query strings which are stored.
+	MaxQueryStringLength int `json:"maxQueryStringLength"`
+}
+
+// SetQueryPropertiesOptions holds the changes of the AQL query tracking configuration.
+// The properties which are not set remain unchanged.
+type SetQueryPropertiesOptions struct {
+	Enabled                     *bool    `json:"enabled,omitempty"`
+	TrackSlowQueries            *bool    `json:"trackSlowQueries,omitempty"`
+	TrackBindVars               *bool    `json:"trackBindVars,omitempty"`
+	MaxSlowQueries              *int     `json:"maxSlowQueries,omitempty"`
+	SlowQueryThreshold          *float64 `json:"slowQueryThreshold,omitempty"`
+	SlowStreamingQueryThreshold *float64 `json:"slowStreamingQueryThreshold,omitempty"`
+	MaxQueryStringLength        *int     `json:"maxQueryStringLength,omitempty"`
+}
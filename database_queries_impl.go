@@ -0,0 +1,137 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"net/http"
+	"path"
+)
+
+func (d *database) RunningQueries(ctx context.Context, opts *QueryTrackingOptions) ([]RunningAQLQuery, error) {
+	return d.listQueries(ctx, "current", opts)
+}
+
+func (d *database) SlowQueries(ctx context.Context, opts *QueryTrackingOptions) ([]RunningAQLQuery, error) {
+	return d.listQueries(ctx, "slow", opts)
+}
+
+func (d *database) listQueries(ctx context.Context, list string, opts *QueryTrackingOptions) ([]RunningAQLQuery, error) {
+	req, err := d.conn.NewRequest("GET", path.Join(d.relPath(), "_api/query", list))
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	opts.applyTo(req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if err := resp.CheckStatus(http.StatusOK); err != nil {
+		return nil, WithStack(err)
+	}
+
+	responses, err := resp.ParseArrayBody()
+	if err != nil {
+		return nil, WithStack(err)
+	}
+
+	data := make([]RunningAQLQuery, 0, len(responses))
+	for _, response := range responses {
+		var query RunningAQLQuery
+		if err := response.ParseBody("", &query); err != nil {
+			return nil, WithStack(err)
+		}
+		data = append(data, query)
+	}
+	return data, nil
+}
+
+func (d *database) ClearSlowQueries(ctx context.Context, opts *QueryTrackingOptions) error {
+	return d.deleteQuery(ctx, "slow", opts)
+}
+
+func (d *database) KillQuery(ctx context.Context, queryID string, opts *QueryTrackingOptions) error {
+	return d.deleteQuery(ctx, pathEscape(queryID), opts)
+}
+
+func (d *database) deleteQuery(ctx context.Context, name string, opts *QueryTrackingOptions) error {
+	req, err := d.conn.NewRequest("DELETE", path.Join(d.relPath(), "_api/query", name))
+	if err != nil {
+		return WithStack(err)
+	}
+	opts.applyTo(req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(http.StatusOK); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+func (d *database) QueryProperties(ctx context.Context) (QueryProperties, error) {
+	req, err := d.conn.NewRequest("GET", path.Join(d.relPath(), "_api/query/properties"))
+	if err != nil {
+		return QueryProperties{}, WithStack(err)
+	}
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return QueryProperties{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(http.StatusOK); err != nil {
+		return QueryProperties{}, WithStack(err)
+	}
+	var data QueryProperties
+	if err := resp.ParseBody("", &data); err != nil {
+		return QueryProperties{}, WithStack(err)
+	}
+	return data, nil
+}
+
+func (d *database) SetQueryProperties(ctx context.Context, options SetQueryPropertiesOptions) (QueryProperties, error) {
+	req, err := d.conn.NewRequest("PUT", path.Join(d.relPath(), "_api/query/properties"))
+	if err != nil {
+		return QueryProperties{}, WithStack(err)
+	}
+	if _, err := req.SetBody(options); err != nil {
+		return QueryProperties{}, WithStack(err)
+	}
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return QueryProperties{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(http.StatusOK); err != nil {
+		return QueryProperties{}, WithStack(err)
+	}
+	var data QueryProperties
+	if err := resp.ParseBody("", &data); err != nil {
+		return QueryProperties{}, WithStack(err)
+	}
+	return data, nil
+}
+
+// applyTo sets the query arguments of the options in the given request.
+func (o *QueryTrackingOptions) applyTo(req Request) {
+	if o != nil && o.All {
+		req.SetQuery("all", "true")
+	}
+}
@@ -148,6 +148,11 @@ func (r *httpRequest) Written() bool {
 	return r.written
 }
 
+// GetBody returns the encoded body, which is sent to the server.
+func (r *httpRequest) GetBody() []byte {
+	return r.bodyBuilder.GetBody()
+}
+
 // WroteRequest implements the WroteRequest function of an httptrace.
 // It sets written to true.
 func (r *httpRequest) WroteRequest(httptrace.WroteRequestInfo) {
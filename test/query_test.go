@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -557,3 +558,84 @@ func TestRetryReadDocument(t *testing.T) {
 		}
 	})
 }
+
+// TestQueryTracking lists and kills a running query and checks the slow query log.
+func TestQueryTracking(t *testing.T) {
+	ctx := context.Background()
+	c := createClient(t, nil)
+	db := ensureDatabase(ctx, c, "query_tracking_test", nil, t)
+
+	original, err := db.QueryProperties(ctx)
+	require.NoError(t, err)
+	defer func() {
+		// The properties are global, so they are restored for other tests.
+		_, err := db.SetQueryProperties(ctx, driver.SetQueryPropertiesOptions{
+			Enabled:            &original.Enabled,
+			TrackSlowQueries:   &original.TrackSlowQueries,
+			TrackBindVars:      &original.TrackBindVars,
+			SlowQueryThreshold: &original.SlowQueryThreshold,
+		})
+		require.NoError(t, err)
+	}()
+
+	enabled, threshold := true, 0.5
+	properties, err := db.SetQueryProperties(ctx, driver.SetQueryPropertiesOptions{
+		Enabled:            &enabled,
+		TrackSlowQueries:   &enabled,
+		TrackBindVars:      &enabled,
+		SlowQueryThreshold: &threshold,
+	})
+	require.NoError(t, err)
+	require.True(t, properties.Enabled)
+	require.Equal(t, threshold, properties.SlowQueryThreshold)
+
+	t.Run("Kill running query", func(t *testing.T) {
+		done := make(chan error, 1)
+		go func() {
+			_, err := db.Query(ctx, "RETURN SLEEP(@seconds)", map[string]interface{}{"seconds": 30})
+			done <- err
+		}()
+
+		var running driver.RunningAQLQuery
+		require.Eventually(t, func() bool {
+			queries, err := db.RunningQueries(ctx, nil)
+			if err != nil {
+				return false
+			}
+
+			for _, q := range queries {
+				if q.Query == "RETURN SLEEP(@seconds)" {
+					running = q
+					return true
+				}
+			}
+			return false
+		}, 10*time.Second, 100*time.Millisecond)
+
+		require.Equal(t, db.Name(), running.Database)
+		require.Equal(t, float64(30), running.BindVars["seconds"])
+
+		require.NoError(t, db.KillQuery(ctx, running.ID, nil))
+		require.Error(t, <-done)
+	})
+
+	t.Run("Slow queries", func(t *testing.T) {
+		_, err := db.Query(ctx, "RETURN SLEEP(1)", nil)
+		require.NoError(t, err)
+
+		queries, err := db.SlowQueries(ctx, nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, queries)
+
+		require.NoError(t, db.ClearSlowQueries(ctx, nil))
+
+		queries, err = db.SlowQueries(ctx, nil)
+		require.NoError(t, err)
+		require.Empty(t, queries)
+	})
+
+	t.Run("Kill unknown query", func(t *testing.T) {
+		err := db.KillQuery(ctx, "123456789", nil)
+		require.True(t, driver.IsNotFound(err))
+	})
+}
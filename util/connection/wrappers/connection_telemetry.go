@@ -0,0 +1,464 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package wrappers
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arangodb/go-velocypack"
+
+	"github.com/arangodb/go-driver"
+)
+
+// NewTelemetryConnection returns the connection which instruments the requests with the spans and the metrics.
+// A client span is created for each request, and its context is propagated to the server
+// with the W3C `traceparent` and `tracestate` headers.
+// The request size is the size of the encoded body which is sent to the server. It is known for the requests
+// of the HTTP and the VST connections.
+func NewTelemetryConnection(c driver.Connection, config TelemetryConfiguration) driver.Connection {
+	t := telemetryConnection{
+		connection: c,
+		config:     config,
+	}
+
+	if m := config.Meter; m != nil {
+		t.duration = m.Histogram(MetricRequestDuration, "s", "Duration of the requests to ArangoDB")
+		t.requestSize = m.Histogram(MetricRequestSize, "By", "Size of the request bodies sent to ArangoDB")
+		t.responseSize = m.Histogram(MetricResponseSize, "By", "Size of the response bodies received from ArangoDB")
+	}
+
+	return t
+}
+
+var _ driver.Connection = &telemetryConnection{}
+
+type telemetryConnection struct {
+	connection driver.Connection
+
+	config TelemetryConfiguration
+
+	duration, requestSize, responseSize Histogram
+}
+
+func (t telemetryConnection) NewRequest(method, path string) (driver.Request, error) {
+	r, err := t.connection.NewRequest(method, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &telemetryRequest{request: r}, nil
+}
+
+func (t telemetryConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
+	r, ok := req.(*telemetryRequest)
+	if !ok {
+		return t.connection.Do(ctx, req)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	start := time.Now()
+
+	target := newTelemetryTarget(r)
+	attributes := target.attributes()
+
+	request := r.request
+	var span Span
+	if t.config.Tracer != nil {
+		spanAttributes := attributes
+		if query, ok := t.queryText(r.request, target); ok {
+			spanAttributes = append(spanAttributes[:len(spanAttributes):len(spanAttributes)],
+				Attribute{Key: AttributeDBQueryText, Value: query})
+		}
+
+		ctx, span = t.config.Tracer.Start(ctx, target.operation, spanAttributes...)
+		request = injectTraceContext(request, span.SpanContext())
+	}
+
+	if t.requestSize != nil {
+		if body, ok := encodedBody(r.request); ok {
+			t.requestSize.Record(ctx, float64(len(body)), attributes...)
+		}
+	}
+
+	// The raw response is needed to measure its size, so it is also copied to the one requested by the caller.
+	var raw []byte
+	callerRaw, _ := driver.HasRawResponse(ctx)
+	resp, err := t.connection.Do(driver.WithRawResponse(ctx, &raw), request)
+	if callerRaw != nil {
+		*callerRaw = raw
+	}
+
+	var arangoErr *driver.ArangoError
+	if err == nil {
+		attributes = append(attributes[:len(attributes):len(attributes)],
+			Attribute{Key: AttributeHTTPStatusCode, Value: resp.StatusCode()})
+		if host, port, ok := endpointAddress(resp.Endpoint()); ok {
+			attributes = append(attributes,
+				Attribute{Key: AttributeServerAddress, Value: host}, Attribute{Key: AttributeServerPort, Value: port})
+		}
+
+		if resp.StatusCode() >= http.StatusBadRequest {
+			var e driver.ArangoError
+			if resp.ParseBody("", &e) == nil {
+				e.Code = resp.StatusCode()
+				arangoErr = &e
+				attributes = append(attributes, Attribute{Key: AttributeErrorNum, Value: e.ErrorNum})
+			}
+		}
+	}
+
+	if t.duration != nil {
+		t.duration.Record(ctx, time.Since(start).Seconds(), attributes...)
+	}
+	if t.responseSize != nil && err == nil {
+		t.responseSize.Record(ctx, float64(len(raw)), attributes...)
+	}
+
+	if span != nil {
+		span.SetAttributes(attributes...)
+		if err != nil {
+			span.SetError(err)
+		} else if arangoErr != nil && arangoErr.Code >= http.StatusInternalServerError {
+			// Client errors, e.g. 404, are expected results of the operations, so only server errors fail the span.
+			span.SetError(*arangoErr)
+		}
+		span.End()
+	}
+
+	return resp, err
+}
+
+// queryText returns the AQL query text of the request, according to the configuration.
+// The query is decoded from the encoded body of the request.
+func (t telemetryConnection) queryText(r driver.Request, target telemetryTarget) (string, bool) {
+	if t.config.QueryText == QueryTextOmitted || target.method != http.MethodPost {
+		return "", false
+	}
+
+	if target.route != "/_api/cursor" && target.route != "/_api/explain" {
+		return "", false
+	}
+
+	body, ok := encodedBody(r)
+	if !ok || len(body) == 0 {
+		return "", false
+	}
+
+	var q struct {
+		Query string `json:"query"`
+	}
+	// The body is encoded with VelocyPack, when it is not JSON.
+	if err := json.Unmarshal(body, &q); err != nil {
+		if err := velocypack.Unmarshal(velocypack.Slice(body), &q); err != nil {
+			return "", false
+		}
+	}
+	if q.Query == "" {
+		return "", false
+	}
+
+	if t.config.QueryText == QueryTextRedacted {
+		return redactQuery(q.Query), true
+	}
+
+	return q.Query, true
+}
+
+func (t telemetryConnection) Unmarshal(data driver.RawObject, result interface{}) error {
+	return t.connection.Unmarshal(data, result)
+}
+
+func (t telemetryConnection) Endpoints() []string {
+	return t.connection.Endpoints()
+}
+
+func (t telemetryConnection) UpdateEndpoints(endpoints []string) error {
+	return t.connection.UpdateEndpoints(endpoints)
+}
+
+func (t telemetryConnection) SetAuthentication(authentication driver.Authentication) (driver.Connection, error) {
+	c, err := t.connection.SetAuthentication(authentication)
+	if err != nil {
+		return nil, err
+	}
+
+	t.connection = c
+	return t, nil
+}
+
+func (t telemetryConnection) Protocols() driver.ProtocolSet {
+	return t.connection.Protocols()
+}
+
+var _ driver.Request = &telemetryRequest{}
+
+// telemetryRequest keeps the data of the request which are needed for the span.
+type telemetryRequest struct {
+	request driver.Request
+
+	collection string
+}
+
+func (r *telemetryRequest) copyWith(d driver.Request) *telemetryRequest {
+	c := *r
+	c.request = d
+	return &c
+}
+
+func (r *telemetryRequest) SetQuery(key, value string) driver.Request {
+	if key == "collection" {
+		r.collection = value
+	}
+
+	return r.copyWith(r.request.SetQuery(key, value))
+}
+
+func (r *telemetryRequest) SetBody(body ...interface{}) (driver.Request, error) {
+	d, err := r.request.SetBody(body...)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.copyWith(d), nil
+}
+
+func (r *telemetryRequest) SetBodyArray(bodyArray interface{}, mergeArray []map[string]interface{}) (driver.Request, error) {
+	d, err := r.request.SetBodyArray(bodyArray, mergeArray)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.copyWith(d), nil
+}
+
+func (r *telemetryRequest) SetBodyImportArray(bodyArray interface{}) (driver.Request, error) {
+	d, err := r.request.SetBodyImportArray(bodyArray)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.copyWith(d), nil
+}
+
+func (r *telemetryRequest) SetHeader(key, value string) driver.Request {
+	return r.copyWith(r.request.SetHeader(key, value))
+}
+
+func (r *telemetryRequest) Written() bool {
+	return r.request.Written()
+}
+
+func (r *telemetryRequest) Clone() driver.Request {
+	return r.copyWith(r.request.Clone())
+}
+
+func (r *telemetryRequest) Path() string {
+	return r.request.Path()
+}
+
+func (r *telemetryRequest) Method() string {
+	return r.request.Method()
+}
+
+// telemetryTarget describes the target of the request.
+type telemetryTarget struct {
+	method     string
+	database   string
+	collection string
+	// route is the path of the API, e.g. `/_api/document`, which does not contain the identifiers.
+	route     string
+	operation string
+}
+
+func newTelemetryTarget(r *telemetryRequest) telemetryTarget {
+	t := telemetryTarget{
+		method:   r.Method(),
+		database: "_system",
+	}
+
+	parts := strings.Split(strings.Trim(r.Path(), "/"), "/")
+	if len(parts) >= 2 && parts[0] == "_db" {
+		t.database = pathUnescape(parts[1])
+		parts = parts[2:]
+	}
+
+	if len(parts) >= 3 && parts[0] == "_api" {
+		switch parts[1] {
+		case "collection", "document", "edge", "index", "import":
+			t.collection = pathUnescape(parts[2])
+		}
+	}
+	if t.collection == "" {
+		t.collection = r.collection
+	}
+
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	t.route = "/" + strings.Join(parts, "/")
+	t.operation = t.method + " " + t.route
+
+	return t
+}
+
+func (t telemetryTarget) attributes() []Attribute {
+	attributes := []Attribute{
+		{Key: AttributeDBSystem, Value: "arangodb"},
+		{Key: AttributeDBName, Value: t.database},
+		{Key: AttributeDBOperation, Value: t.operation},
+		{Key: AttributeHTTPMethod, Value: t.method},
+	}
+
+	if t.collection != "" {
+		attributes = append(attributes, Attribute{Key: AttributeDBCollection, Value: t.collection})
+	}
+
+	return attributes
+}
+
+func pathUnescape(s string) string {
+	if u, err := url.PathUnescape(s); err == nil {
+		return u
+	}
+
+	return s
+}
+
+// encodedBody returns the encoded body of the request, which is sent to the server.
+func encodedBody(r driver.Request) ([]byte, bool) {
+	if b, ok := r.(interface{ GetBody() []byte }); ok {
+		return b.GetBody(), true
+	}
+
+	return nil, false
+}
+
+// injectTraceContext sets the W3C trace context headers of the request.
+func injectTraceContext(r driver.Request, sc SpanContext) driver.Request {
+	if !sc.IsValid() {
+		return r
+	}
+
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+
+	r = r.SetHeader("traceparent", "00-"+hex.EncodeToString(sc.TraceID[:])+"-"+hex.EncodeToString(sc.SpanID[:])+"-"+flags)
+	if sc.TraceState != "" {
+		r = r.SetHeader("tracestate", sc.TraceState)
+	}
+
+	return r
+}
+
+// endpointAddress returns the host and the port of the endpoint.
+func endpointAddress(endpoint string) (string, int, bool) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return "", 0, false
+	}
+
+	host, portValue, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		return u.Host, 0, false
+	}
+
+	port, err := strconv.Atoi(portValue)
+	if err != nil {
+		return host, 0, false
+	}
+
+	return host, port, true
+}
+
+// redactQuery replaces the string and the number literals of the AQL query with `?` and removes the comments.
+func redactQuery(query string) string {
+	var b strings.Builder
+	b.Grow(len(query))
+
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"':
+			// The string literal is skipped with the escaped characters.
+			for i++; i < len(query) && query[i] != c; i++ {
+				if query[i] == '\\' {
+					i++
+				}
+			}
+			b.WriteByte('?')
+		case c == '`':
+			// The quoted name is kept.
+			end := strings.IndexByte(query[i+1:], '`')
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+2])
+			i += end + 1
+		case c == '/' && i+1 < len(query) && query[i+1] == '/':
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				return b.String()
+			}
+			b.WriteByte('\n')
+			i += end
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return b.String()
+			}
+			b.WriteByte(' ')
+			i += end + 3
+		case c >= '0' && c <= '9' && !isQueryNameByte(query, i-1):
+			for i+1 < len(query) && (isQueryNameByte(query, i+1) || query[i+1] == '.' && i+2 < len(query) &&
+				query[i+2] >= '0' && query[i+2] <= '9') {
+				i++
+			}
+			b.WriteByte('?')
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// isQueryNameByte returns true when the byte at the index can be a part of a name or a number.
+func isQueryNameByte(query string, i int) bool {
+	if i < 0 || i >= len(query) {
+		return false
+	}
+
+	c := query[i]
+	return c == '_' || c == '@' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,116 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package wrappers
+
+import (
+	"context"
+)
+
+// The names of the attributes of the spans and the metrics, according to the OpenTelemetry semantic conventions.
+const (
+	AttributeDBSystem       = "db.system"
+	AttributeDBName         = "db.name"
+	AttributeDBCollection   = "db.collection.name"
+	AttributeDBOperation    = "db.operation.name"
+	AttributeDBQueryText    = "db.query.text"
+	AttributeHTTPMethod     = "http.request.method"
+	AttributeHTTPStatusCode = "http.response.status_code"
+	AttributeErrorNum       = "db.arangodb.error_num"
+	AttributeServerAddress  = "server.address"
+	AttributeServerPort     = "server.port"
+)
+
+// The names of the histograms recorded by the telemetry connection.
+const (
+	MetricRequestDuration = "db.client.operation.duration"
+	MetricRequestSize     = "db.client.request.size"
+	MetricResponseSize    = "db.client.response.size"
+)
+
+// Attribute is a key-value pair which describes a span or a measurement.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// SpanContext identifies a span in the W3C trace context.
+type SpanContext struct {
+	TraceID    [16]byte
+	SpanID     [8]byte
+	Sampled    bool
+	TraceState string
+}
+
+// IsValid returns true when the trace ID and the span ID are set.
+func (s SpanContext) IsValid() bool {
+	return s.TraceID != [16]byte{} && s.SpanID != [8]byte{}
+}
+
+// Span is a single traced request. It can be implemented with an adapter to an OpenTelemetry trace.Span.
+type Span interface {
+	// SpanContext returns the identifiers of the span which are propagated to the server.
+	SpanContext() SpanContext
+	SetAttributes(attributes ...Attribute)
+	// SetError records the error and marks the span as failed.
+	SetError(err error)
+	End()
+}
+
+// Tracer starts the spans. It can be implemented with an adapter to an OpenTelemetry trace.Tracer,
+// which starts the spans with the client kind.
+type Tracer interface {
+	Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+}
+
+// Histogram records the distribution of the values. It can be implemented with an adapter to an OpenTelemetry
+// metric.Float64Histogram.
+type Histogram interface {
+	Record(ctx context.Context, value float64, attributes ...Attribute)
+}
+
+// Meter creates the histograms. It can be implemented with an adapter to an OpenTelemetry metric.Meter.
+type Meter interface {
+	Histogram(name, unit, description string) Histogram
+}
+
+// QueryTextMode defines how the AQL query text is recorded.
+type QueryTextMode int
+
+const (
+	// QueryTextOmitted does not record the query text.
+	QueryTextOmitted QueryTextMode = iota
+	// QueryTextRedacted records the query text with the string and the number literals replaced with `?`.
+	// Comments are removed. The bind parameters are never recorded.
+	QueryTextRedacted
+	// QueryTextFull records the query text as it is.
+	QueryTextFull
+)
+
+// TelemetryConfiguration is the configuration of the connection returned by NewTelemetryConnection.
+type TelemetryConfiguration struct {
+	// Tracer starts a span for each request. No spans are created when it is nil.
+	Tracer Tracer
+	// Meter creates the histograms of the request duration (in seconds) and of the request and response size
+	// (in bytes). No metrics are recorded when it is nil.
+	Meter Meter
+	// QueryText defines how the text of the AQL queries is recorded in the spans. It is omitted by default.
+	QueryText QueryTextMode
+}
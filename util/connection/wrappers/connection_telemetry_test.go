@@ -0,0 +1,149 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package wrappers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	driverhttp "github.com/arangodb/go-driver/http"
+)
+
+type testSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (s *testSpan) SpanContext() SpanContext {
+	return SpanContext{TraceID: [16]byte{1}, SpanID: [8]byte{2}, Sampled: true}
+}
+
+func (s *testSpan) SetAttributes(attributes ...Attribute) {
+	for _, a := range attributes {
+		s.attributes[a.Key] = a.Value
+	}
+}
+
+func (s *testSpan) SetError(err error) {
+	s.err = err
+}
+
+func (s *testSpan) End() {
+	s.ended = true
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span) {
+	s := &testSpan{name: name, attributes: map[string]interface{}{}}
+	s.SetAttributes(attributes...)
+	t.spans = append(t.spans, s)
+
+	return ctx, s
+}
+
+type testHistogram []float64
+
+func (h *testHistogram) Record(_ context.Context, value float64, _ ...Attribute) {
+	*h = append(*h, value)
+}
+
+type testMeter map[string]*testHistogram
+
+func (m testMeter) Histogram(name, _, _ string) Histogram {
+	m[name] = &testHistogram{}
+	return m[name]
+}
+
+func TestTelemetryConnection(t *testing.T) {
+	var traceParent string
+	var requestSize int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get("traceparent")
+		body, _ := io.ReadAll(r.Body)
+		requestSize = len(body)
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.HasSuffix(r.URL.Path, "/_api/cursor") {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"result":[1]}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error":true,"code":503,"errorNum":503,"errorMessage":"unavailable"}`))
+	}))
+	defer server.Close()
+
+	conn, err := driverhttp.NewConnection(driverhttp.ConnectionConfig{Endpoints: []string{server.URL}})
+	require.NoError(t, err)
+
+	tracer := &testTracer{}
+	meter := testMeter{}
+	conn = NewTelemetryConnection(conn, TelemetryConfiguration{Tracer: tracer, Meter: meter, QueryText: QueryTextRedacted})
+
+	req, err := conn.NewRequest(http.MethodPost, "_db/mydb/_api/cursor")
+	require.NoError(t, err)
+	req, err = req.SetBody(map[string]interface{}{"query": "FOR d IN users FILTER d.age > 18 RETURN d"})
+	require.NoError(t, err)
+
+	resp, err := conn.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.NoError(t, resp.CheckStatus(http.StatusCreated))
+
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	assert.Equal(t, "POST /_api/cursor", span.name)
+	assert.Equal(t, "mydb", span.attributes[AttributeDBName])
+	assert.Equal(t, http.StatusCreated, span.attributes[AttributeHTTPStatusCode])
+	assert.Equal(t, "FOR d IN users FILTER d.age > ? RETURN d", span.attributes[AttributeDBQueryText])
+	assert.NoError(t, span.err)
+	assert.True(t, span.ended)
+	assert.Equal(t, "00-01000000000000000000000000000000-0200000000000000-01", traceParent)
+
+	assert.Len(t, *meter[MetricRequestDuration], 1)
+	assert.Equal(t, float64(requestSize), (*meter[MetricRequestSize])[0])
+	assert.Equal(t, float64(len(`{"result":[1]}`)), (*meter[MetricResponseSize])[0])
+
+	req, err = conn.NewRequest(http.MethodGet, "_api/collection/users")
+	require.NoError(t, err)
+
+	_, err = conn.Do(context.Background(), req)
+	require.NoError(t, err)
+
+	require.Len(t, tracer.spans, 2)
+	span = tracer.spans[1]
+	assert.Equal(t, "_system", span.attributes[AttributeDBName])
+	assert.Equal(t, "users", span.attributes[AttributeDBCollection])
+	assert.Equal(t, 503, span.attributes[AttributeErrorNum])
+	assert.Error(t, span.err)
+}
@@ -0,0 +1,183 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package aql
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+var paramNameRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_]*$`)
+
+// BindParameters returns the names of the bind parameters used in the query text, in the order of their first use.
+// The names are returned as they are used as keys of the bind variables, i.e. "name" for @name
+// and "@name" for the collection parameter @@name.
+// Bind parameters in string literals, quoted names and comments are ignored.
+func BindParameters(query string) []string {
+	var names []string
+	seen := map[string]bool{}
+
+	for i := 0; i < len(query); i++ {
+		switch c := query[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(query, i, c)
+		case strings.HasPrefix(query[i:], "´"):
+			i = skipUntil(query, i+len("´"), "´")
+		case strings.HasPrefix(query[i:], "//"):
+			i = skipUntil(query, i+2, "\n")
+		case strings.HasPrefix(query[i:], "/*"):
+			i = skipUntil(query, i+2, "*/")
+		case c == '@':
+			start := i + 1
+			if start < len(query) && query[start] == '@' {
+				start++
+			}
+
+			end := start
+			for end < len(query) && isNameChar(query[end]) {
+				end++
+			}
+
+			if end > start {
+				// The key of a collection parameter keeps one '@'.
+				name := query[i+1 : end]
+				if !seen[name] {
+					seen[name] = true
+					names = append(names, name)
+				}
+			}
+			i = end - 1
+		}
+	}
+
+	return names
+}
+
+// ValidateBindVars checks that every bind parameter used in the query text has a value in the bind variables,
+// and that all bind variables are used in the query text.
+// It returns shared.InvalidArgumentError which lists the missing and unused bind parameters.
+func ValidateBindVars(query string, bindVars map[string]interface{}) error {
+	used := BindParameters(query)
+
+	var missing, unused []string
+	usedSet := make(map[string]bool, len(used))
+	for _, name := range used {
+		usedSet[name] = true
+		if _, ok := bindVars[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	for name := range bindVars {
+		if !usedSet[name] {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+
+	if len(missing) == 0 && len(unused) == 0 {
+		return nil
+	}
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, "missing bind parameters: "+formatParams(missing))
+	}
+	if len(unused) > 0 {
+		problems = append(problems, "unused bind parameters: "+formatParams(unused))
+	}
+
+	return shared.InvalidArgumentError{Message: strings.Join(problems, ", ")}
+}
+
+// formatParams returns the bind parameters as they are written in the query text.
+func formatParams(names []string) string {
+	params := make([]string, len(names))
+	for i, name := range names {
+		params[i] = "@" + name
+	}
+
+	return strings.Join(params, ", ")
+}
+
+func isNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// skipQuoted returns the position of the quote which ends the quoted text starting at the given position.
+func skipQuoted(query string, start int, quote byte) int {
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			i++
+		case quote:
+			return i
+		}
+	}
+
+	return len(query)
+}
+
+// skipUntil returns the position of the last byte of the first occurrence of end after the given position.
+func skipUntil(query string, start int, end string) int {
+	if index := strings.Index(query[start:], end); index >= 0 {
+		return start + index + len(end) - 1
+	}
+
+	return len(query)
+}
+
+// binder collects the bind variables while a query is rendered.
+type binder struct {
+	bindVars map[string]interface{}
+	next     int
+}
+
+func newBinder() *binder {
+	return &binder{bindVars: map[string]interface{}{}}
+}
+
+// bind adds the bind variable with the given key.
+// The same key can be bound multiple times, but only to the same value.
+func (b *binder) bind(key string, value interface{}) error {
+	if existing, ok := b.bindVars[key]; ok && !reflect.DeepEqual(existing, value) {
+		return shared.InvalidArgumentError{
+			Message: fmt.Sprintf("bind parameter @%s is bound to different values: %v and %v", key, existing, value),
+		}
+	}
+	b.bindVars[key] = value
+
+	return nil
+}
+
+// bindAuto adds the bind variable with a generated name and returns the key.
+func (b *binder) bindAuto(prefix string, value interface{}) string {
+	key := fmt.Sprintf("%s%d", prefix, b.next)
+	b.next++
+	b.bindVars[key] = value
+
+	return key
+}
@@ -0,0 +1,98 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package aql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func TestBindParameters(t *testing.T) {
+	testCases := map[string]struct {
+		query    string
+		expected []string
+	}{
+		"none":            {query: "FOR d IN users RETURN d"},
+		"value":           {query: "FOR d IN users FILTER d.age > @age RETURN d", expected: []string{"age"}},
+		"collection":      {query: "FOR d IN @@col RETURN d", expected: []string{"@col"}},
+		"used twice":      {query: "FOR d IN @@col FILTER d.a == @x OR d.b == @x RETURN d", expected: []string{"@col", "x"}},
+		"string literals": {query: `RETURN ['@a', "@b", 'it\'s @c', @d]`, expected: []string{"d"}},
+		"quoted names":    {query: "RETURN [d.`@a`, d.´@b´, @c]", expected: []string{"c"}},
+		"comments":        {query: "RETURN @a // @b\n + @c /* @d */", expected: []string{"a", "c"}},
+		"adjacent":        {query: "RETURN [@a,@b]", expected: []string{"a", "b"}},
+		"no name":         {query: "RETURN '@' == @"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, BindParameters(tc.query))
+		})
+	}
+}
+
+func TestValidateBindVars(t *testing.T) {
+	testCases := map[string]struct {
+		query    string
+		bindVars map[string]interface{}
+		expected string
+	}{
+		"valid": {
+			query:    "FOR d IN @@col FILTER d.age > @age RETURN d",
+			bindVars: map[string]interface{}{"@col": "users", "age": 18},
+		},
+		"no bind parameters": {
+			query: "RETURN 1",
+		},
+		"missing": {
+			query:    "FOR d IN @@col FILTER d.age > @age RETURN d",
+			bindVars: map[string]interface{}{"age": 18},
+			expected: "missing bind parameters: @@col",
+		},
+		"unused": {
+			query:    "FOR d IN users RETURN d",
+			bindVars: map[string]interface{}{"b": 1, "a": 2},
+			expected: "unused bind parameters: @a, @b",
+		},
+		"collection bound as value": {
+			query:    "FOR d IN @@col RETURN d",
+			bindVars: map[string]interface{}{"col": "users"},
+			expected: "missing bind parameters: @@col, unused bind parameters: @col",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateBindVars(tc.query, tc.bindVars)
+			if tc.expected == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.True(t, shared.IsInvalidArgument(err))
+			assert.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
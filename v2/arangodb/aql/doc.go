@@ -0,0 +1,45 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+/*
+Package aql provides a builder which composes AQL queries and tracks their bind parameters.
+
+Values and collection names are never inlined into the query text, they are always passed as bind parameters:
+
+	q := aql.New().
+		For("u", aql.Collection("users")).
+		Filter(aql.Compare(aql.Var("u").Attr("age"), ">=", aql.Value(18))).
+		Sort(aql.Desc(aql.Var("u").Attr("age"))).
+		Limit(10).
+		Return(aql.Var("u"))
+
+	cursor, err := q.Execute(ctx, db, nil)
+
+renders the query "FOR u IN @@_c0 FILTER u.age >= @_v1 SORT u.age DESC LIMIT 10 RETURN u"
+with the bind parameters {"@_c0": "users", "_v1": 18}.
+
+Bind parameters named by the caller are added with Param, CollectionParam and Expr.
+Names starting with an underscore are reserved for the parameters named by the builder.
+
+Before a query is sent, it is checked that every bind parameter used in the query text has a value,
+and that no value is passed for a bind parameter which is not used. ValidateBindVars does the same for queries
+which are not created by the builder.
+*/
+package aql
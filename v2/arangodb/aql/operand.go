@@ -0,0 +1,350 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package aql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+var (
+	variableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	functionNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(::[A-Za-z_][A-Za-z0-9_]*)*$`)
+)
+
+var comparisonOperators = map[string]bool{
+	"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true,
+	"IN": true, "NOT IN": true, "LIKE": true, "NOT LIKE": true, "=~": true, "!~": true,
+	"ANY ==": true, "ALL ==": true, "NONE ==": true, "ANY IN": true, "ALL IN": true, "NONE IN": true,
+}
+
+// Operand is a part of an AQL expression.
+// Use Var, Value, Param, Collection, CollectionParam, Expr, Func, Compare, And, Or, Not or Subquery to create it.
+type Operand interface {
+	render(b *binder) (string, error)
+}
+
+// Variable is an AQL variable or an attribute of it.
+type Variable struct {
+	name string
+	path []string
+}
+
+// Var returns the AQL variable with the given name.
+func Var(name string) Variable {
+	return Variable{name: name}
+}
+
+// Attr returns the nested attribute of the variable, e.g. Var("u").Attr("address", "city") renders u.address.city.
+// Attribute names which are not valid identifiers are quoted.
+func (v Variable) Attr(names ...string) Variable {
+	path := make([]string, 0, len(v.path)+len(names))
+	path = append(path, v.path...)
+	path = append(path, names...)
+
+	return Variable{name: v.name, path: path}
+}
+
+func (v Variable) render(_ *binder) (string, error) {
+	if err := validateVariableName(v.name); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(v.name)
+	for _, attribute := range v.path {
+		sb.WriteString(".")
+		if variableNameRegex.MatchString(attribute) {
+			sb.WriteString(attribute)
+		} else {
+			sb.WriteString(quoteName(attribute))
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// BindParam is a bind parameter named by the caller.
+type BindParam struct {
+	name       string
+	value      interface{}
+	collection bool
+}
+
+// Param returns the bind parameter @name with the given value.
+// The name must not start with an underscore, such names are reserved for the builder.
+func Param(name string, value interface{}) BindParam {
+	return BindParam{name: name, value: value}
+}
+
+// CollectionParam returns the collection bind parameter @@name with the given collection name.
+// The name must not start with an underscore, such names are reserved for the builder.
+func CollectionParam(name string, collection string) BindParam {
+	return BindParam{name: name, value: collection, collection: true}
+}
+
+func (p BindParam) key() string {
+	if p.collection {
+		return "@" + p.name
+	}
+
+	return p.name
+}
+
+func (p BindParam) bind(b *binder) error {
+	if !paramNameRegex.MatchString(p.name) {
+		return shared.InvalidArgumentError{Message: fmt.Sprintf("invalid bind parameter name '%s'", p.name)}
+	}
+
+	if p.collection {
+		if name, ok := p.value.(string); !ok || name == "" {
+			return shared.InvalidArgumentError{Message: fmt.Sprintf("collection name of bind parameter @@%s is empty", p.name)}
+		}
+	}
+
+	return b.bind(p.key(), p.value)
+}
+
+func (p BindParam) render(b *binder) (string, error) {
+	if err := p.bind(b); err != nil {
+		return "", err
+	}
+
+	return "@" + p.key(), nil
+}
+
+type value struct {
+	value interface{}
+}
+
+// Value returns the value as a bind parameter with a generated name.
+func Value(v interface{}) Operand {
+	return value{value: v}
+}
+
+func (v value) render(b *binder) (string, error) {
+	return "@" + b.bindAuto("_v", v.value), nil
+}
+
+type collection struct {
+	name string
+}
+
+// Collection returns the collection as a collection bind parameter with a generated name.
+func Collection(name string) Operand {
+	return collection{name: name}
+}
+
+func (c collection) render(b *binder) (string, error) {
+	if c.name == "" {
+		return "", shared.InvalidArgumentError{Message: "collection name is empty"}
+	}
+
+	return "@" + b.bindAuto("@_c", c.name), nil
+}
+
+type expression struct {
+	text   string
+	params []BindParam
+}
+
+// Expr returns the AQL expression given as text, with the bind parameters which are used in it.
+// The text is inserted into the query as it is, so it must not contain any values from untrusted sources.
+func Expr(text string, params ...BindParam) Operand {
+	return expression{text: text, params: params}
+}
+
+func (e expression) render(b *binder) (string, error) {
+	if strings.TrimSpace(e.text) == "" {
+		return "", shared.InvalidArgumentError{Message: "expression is empty"}
+	}
+
+	for _, p := range e.params {
+		if err := p.bind(b); err != nil {
+			return "", err
+		}
+	}
+
+	return e.text, nil
+}
+
+type function struct {
+	name string
+	args []Operand
+}
+
+// Func returns the call of the AQL function with the given arguments, e.g. Func("LENGTH", Var("u").Attr("tags")).
+func Func(name string, args ...Operand) Operand {
+	return function{name: name, args: args}
+}
+
+func (f function) render(b *binder) (string, error) {
+	if !functionNameRegex.MatchString(f.name) {
+		return "", shared.InvalidArgumentError{Message: fmt.Sprintf("invalid function name '%s'", f.name)}
+	}
+
+	args, err := renderOperands(b, f.args)
+	if err != nil {
+		return "", err
+	}
+
+	return f.name + "(" + strings.Join(args, ", ") + ")", nil
+}
+
+type comparison struct {
+	left     Operand
+	operator string
+	right    Operand
+}
+
+// Compare returns the comparison of the operands, e.g. Compare(Var("u").Attr("age"), ">=", Value(18)).
+// The operator is one of ==, !=, <, <=, >, >=, IN, NOT IN, LIKE, NOT LIKE, =~, !~
+// or an array comparison operator, e.g. ANY ==.
+func Compare(left Operand, operator string, right Operand) Operand {
+	return comparison{left: left, operator: operator, right: right}
+}
+
+func (c comparison) render(b *binder) (string, error) {
+	if !comparisonOperators[c.operator] {
+		return "", shared.InvalidArgumentError{Message: fmt.Sprintf("invalid comparison operator '%s'", c.operator)}
+	}
+
+	operands, err := renderOperands(b, []Operand{c.left, c.right})
+	if err != nil {
+		return "", err
+	}
+
+	return operands[0] + " " + c.operator + " " + operands[1], nil
+}
+
+type logical struct {
+	operator string
+	operands []Operand
+}
+
+// And returns the conjunction of the conditions.
+func And(conditions ...Operand) Operand {
+	return logical{operator: "AND", operands: conditions}
+}
+
+// Or returns the disjunction of the conditions.
+func Or(conditions ...Operand) Operand {
+	return logical{operator: "OR", operands: conditions}
+}
+
+func (l logical) render(b *binder) (string, error) {
+	if len(l.operands) == 0 {
+		return "", shared.InvalidArgumentError{Message: fmt.Sprintf("%s requires at least one condition", l.operator)}
+	}
+
+	operands, err := renderOperands(b, l.operands)
+	if err != nil {
+		return "", err
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+
+	for i, operand := range l.operands {
+		if _, ok := operand.(expression); ok {
+			// The text of an expression may contain operators with a lower precedence.
+			operands[i] = "(" + operands[i] + ")"
+		}
+	}
+
+	return "(" + strings.Join(operands, " "+l.operator+" ") + ")", nil
+}
+
+type negation struct {
+	condition Operand
+}
+
+// Not returns the negation of the condition.
+func Not(condition Operand) Operand {
+	return negation{condition: condition}
+}
+
+func (n negation) render(b *binder) (string, error) {
+	operands, err := renderOperands(b, []Operand{n.condition})
+	if err != nil {
+		return "", err
+	}
+
+	return "NOT (" + operands[0] + ")", nil
+}
+
+type subquery struct {
+	query *Query
+}
+
+// Subquery returns the query as a subquery, e.g. Let("orders", Subquery(aql.New().For("o", ...).Return(...))).
+// The bind parameters of the subquery are added to the bind parameters of the query.
+func Subquery(q *Query) Operand {
+	return subquery{query: q}
+}
+
+func (s subquery) render(b *binder) (string, error) {
+	if s.query == nil {
+		return "", shared.InvalidArgumentError{Message: "subquery is nil"}
+	}
+
+	text, err := s.query.render(b)
+	if err != nil {
+		return "", err
+	}
+
+	return "(" + text + ")", nil
+}
+
+func renderOperands(b *binder, operands []Operand) ([]string, error) {
+	result := make([]string, len(operands))
+	for i, operand := range operands {
+		if operand == nil {
+			return nil, shared.InvalidArgumentError{Message: "operand is nil"}
+		}
+
+		text, err := operand.render(b)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = text
+	}
+
+	return result, nil
+}
+
+func validateVariableName(name string) error {
+	if !variableNameRegex.MatchString(name) {
+		return shared.InvalidArgumentError{Message: fmt.Sprintf("invalid variable name '%s'", name)}
+	}
+
+	return nil
+}
+
+// quoteName quotes the name with backticks.
+func quoteName(name string) string {
+	name = strings.ReplaceAll(name, `\`, `\\`)
+	return "`" + strings.ReplaceAll(name, "`", "\\`") + "`"
+}
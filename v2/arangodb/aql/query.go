@@ -0,0 +1,343 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package aql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// Query is an AQL query builder. The statements are added in the order of the calls.
+// All methods return the query, so the calls can be chained. The errors are returned by Build.
+type Query struct {
+	statements []statement
+}
+
+// statement renders a single AQL statement.
+type statement func(b *binder) (string, error)
+
+// SortCriterion is a criterion of the SORT statement.
+type SortCriterion struct {
+	operand   Operand
+	direction string
+}
+
+// Asc sorts by the operand in ascending order.
+func Asc(operand Operand) SortCriterion {
+	return SortCriterion{operand: operand, direction: "ASC"}
+}
+
+// Desc sorts by the operand in descending order.
+func Desc(operand Operand) SortCriterion {
+	return SortCriterion{operand: operand, direction: "DESC"}
+}
+
+// Assignment assigns the value to the variable, e.g. a group or an aggregate of the COLLECT statement.
+type Assignment struct {
+	variable string
+	value    Operand
+}
+
+// Assign assigns the value to the variable.
+func Assign(variable string, value Operand) Assignment {
+	return Assignment{variable: variable, value: value}
+}
+
+func (a Assignment) render(b *binder) (string, error) {
+	if err := validateVariableName(a.variable); err != nil {
+		return "", err
+	}
+
+	value, err := renderOperands(b, []Operand{a.value})
+	if err != nil {
+		return "", err
+	}
+
+	return a.variable + " = " + value[0], nil
+}
+
+// New returns an empty query.
+func New() *Query {
+	return &Query{}
+}
+
+// For adds the statement FOR variable IN in.
+func (q *Query) For(variable string, in Operand) *Query {
+	return q.add(func(b *binder) (string, error) {
+		if err := validateVariableName(variable); err != nil {
+			return "", err
+		}
+
+		operands, err := renderOperands(b, []Operand{in})
+		if err != nil {
+			return "", err
+		}
+
+		return "FOR " + variable + " IN " + operands[0], nil
+	})
+}
+
+// Filter adds the statement FILTER condition.
+func (q *Query) Filter(condition Operand) *Query {
+	return q.add(func(b *binder) (string, error) {
+		operands, err := renderOperands(b, []Operand{condition})
+		if err != nil {
+			return "", err
+		}
+
+		return "FILTER " + operands[0], nil
+	})
+}
+
+// Let adds the statement LET variable = value.
+func (q *Query) Let(variable string, value Operand) *Query {
+	return q.add(func(b *binder) (string, error) {
+		assignment, err := Assign(variable, value).render(b)
+		if err != nil {
+			return "", err
+		}
+
+		return "LET " + assignment, nil
+	})
+}
+
+// Sort adds the statement SORT with the given criteria.
+func (q *Query) Sort(criteria ...SortCriterion) *Query {
+	return q.add(func(b *binder) (string, error) {
+		if len(criteria) == 0 {
+			return "", shared.InvalidArgumentError{Message: "SORT requires at least one criterion"}
+		}
+
+		parts := make([]string, len(criteria))
+		for i, criterion := range criteria {
+			operands, err := renderOperands(b, []Operand{criterion.operand})
+			if err != nil {
+				return "", err
+			}
+			parts[i] = operands[0] + " " + criterion.direction
+		}
+
+		return "SORT " + strings.Join(parts, ", "), nil
+	})
+}
+
+// Limit adds the statement LIMIT count.
+func (q *Query) Limit(count int) *Query {
+	return q.LimitOffset(0, count)
+}
+
+// LimitOffset adds the statement LIMIT offset, count.
+func (q *Query) LimitOffset(offset, count int) *Query {
+	return q.add(func(_ *binder) (string, error) {
+		if offset < 0 || count < 0 {
+			return "", shared.InvalidArgumentError{Message: fmt.Sprintf("invalid LIMIT %d, %d", offset, count)}
+		}
+
+		if offset == 0 {
+			return fmt.Sprintf("LIMIT %d", count), nil
+		}
+
+		return fmt.Sprintf("LIMIT %d, %d", offset, count), nil
+	})
+}
+
+// Collect adds the statement COLLECT with the given groups.
+func (q *Query) Collect(groups ...Assignment) *Query {
+	return q.collect(groups, nil, "", "")
+}
+
+// CollectInto adds the statement COLLECT with the given groups, which stores the grouped items in the variable.
+func (q *Query) CollectInto(into string, groups ...Assignment) *Query {
+	return q.collect(groups, nil, "INTO", into)
+}
+
+// CollectWithCount adds the statement COLLECT with the given groups, which stores the number of grouped items in the variable.
+func (q *Query) CollectWithCount(into string, groups ...Assignment) *Query {
+	return q.collect(groups, nil, "WITH COUNT INTO", into)
+}
+
+// CollectAggregate adds the statement COLLECT with the given groups and aggregates,
+// e.g. CollectAggregate([]Assignment{Assign("city", ...)}, []Assignment{Assign("total", Func("SUM", ...))}).
+func (q *Query) CollectAggregate(groups []Assignment, aggregates []Assignment) *Query {
+	return q.collect(groups, aggregates, "", "")
+}
+
+func (q *Query) collect(groups []Assignment, aggregates []Assignment, intoKeyword, into string) *Query {
+	return q.add(func(b *binder) (string, error) {
+		parts := []string{"COLLECT"}
+
+		if len(groups) > 0 {
+			assignments, err := renderAssignments(b, groups)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, assignments)
+		}
+
+		if len(aggregates) > 0 {
+			assignments, err := renderAssignments(b, aggregates)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, "AGGREGATE "+assignments)
+		}
+
+		if intoKeyword != "" {
+			if err := validateVariableName(into); err != nil {
+				return "", err
+			}
+			parts = append(parts, intoKeyword+" "+into)
+		}
+
+		if len(parts) == 1 {
+			return "", shared.InvalidArgumentError{Message: "COLLECT requires groups, aggregates or a count"}
+		}
+
+		return strings.Join(parts, " "), nil
+	})
+}
+
+// Return adds the statement RETURN value.
+func (q *Query) Return(value Operand) *Query {
+	return q.ret("RETURN ", value)
+}
+
+// ReturnDistinct adds the statement RETURN DISTINCT value.
+func (q *Query) ReturnDistinct(value Operand) *Query {
+	return q.ret("RETURN DISTINCT ", value)
+}
+
+func (q *Query) ret(prefix string, value Operand) *Query {
+	return q.add(func(b *binder) (string, error) {
+		operands, err := renderOperands(b, []Operand{value})
+		if err != nil {
+			return "", err
+		}
+
+		return prefix + operands[0], nil
+	})
+}
+
+// Raw adds the statement given as text, with the bind parameters which are used in it,
+// e.g. Raw("INSERT @doc INTO @@col", Param("doc", doc), CollectionParam("col", "users")).
+// The text is inserted into the query as it is, so it must not contain any values from untrusted sources.
+func (q *Query) Raw(text string, params ...BindParam) *Query {
+	return q.add(Expr(text, params...).render)
+}
+
+func (q *Query) add(s statement) *Query {
+	q.statements = append(q.statements, s)
+	return q
+}
+
+func (q *Query) render(b *binder) (string, error) {
+	if len(q.statements) == 0 {
+		return "", shared.InvalidArgumentError{Message: "query is empty"}
+	}
+
+	parts := make([]string, len(q.statements))
+	for i, s := range q.statements {
+		text, err := s(b)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = text
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+// Build returns the query text and its bind variables.
+// It returns shared.InvalidArgumentError when the query is invalid,
+// or when the bind variables do not match the bind parameters used in the query text.
+func (q *Query) Build() (string, map[string]interface{}, error) {
+	b := newBinder()
+
+	text, err := q.render(b)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := ValidateBindVars(text, b.bindVars); err != nil {
+		return "", nil, err
+	}
+
+	return text, b.bindVars, nil
+}
+
+// String returns the query text, or an empty string when the query is invalid.
+func (q *Query) String() string {
+	text, _, _ := q.Build()
+	return text
+}
+
+// QueryOptions returns the query text and a copy of the options with the bind variables of the query.
+// Bind variables which are already set in the options are kept, and are validated together with the ones of the query.
+func (q *Query) QueryOptions(opts *arangodb.QueryOptions) (string, *arangodb.QueryOptions, error) {
+	b := newBinder()
+
+	var result arangodb.QueryOptions
+	if opts != nil {
+		result = *opts
+		for key, value := range opts.BindVars {
+			b.bindVars[key] = value
+		}
+	}
+
+	text, err := q.render(b)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := ValidateBindVars(text, b.bindVars); err != nil {
+		return "", nil, err
+	}
+	result.BindVars = b.bindVars
+
+	return text, &result, nil
+}
+
+// Execute builds the query and runs it.
+func (q *Query) Execute(ctx context.Context, db arangodb.DatabaseQuery, opts *arangodb.QueryOptions) (arangodb.Cursor, error) {
+	text, queryOpts, err := q.QueryOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.Query(ctx, text, queryOpts)
+}
+
+func renderAssignments(b *binder, assignments []Assignment) (string, error) {
+	parts := make([]string, len(assignments))
+	for i, assignment := range assignments {
+		text, err := assignment.render(b)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = text
+	}
+
+	return strings.Join(parts, ", "), nil
+}
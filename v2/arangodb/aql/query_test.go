@@ -0,0 +1,212 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package aql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func TestQuery_Build(t *testing.T) {
+	u := Var("u")
+
+	testCases := map[string]struct {
+		query    *Query
+		text     string
+		bindVars map[string]interface{}
+	}{
+		"filter, sort and limit": {
+			query: New().
+				For("u", Collection("users")).
+				Filter(And(Compare(u.Attr("age"), ">=", Value(18)), Compare(u.Attr("active"), "==", Value(true)))).
+				Sort(Desc(u.Attr("age")), Asc(u.Attr("name"))).
+				LimitOffset(20, 10).
+				Return(u),
+			text:     "FOR u IN @@_c0 FILTER (u.age >= @_v1 AND u.active == @_v2) SORT u.age DESC, u.name ASC LIMIT 20, 10 RETURN u",
+			bindVars: map[string]interface{}{"@_c0": "users", "_v1": 18, "_v2": true},
+		},
+		"named parameters": {
+			query: New().
+				For("u", CollectionParam("col", "users")).
+				Filter(Expr("u.age >= @min OR u.name == @name", Param("min", 18), Param("name", "admin"))).
+				Filter(Compare(u.Attr("age"), "<", Param("max", 65))).
+				Limit(10).
+				Return(u.Attr("first name")),
+			text:     "FOR u IN @@col FILTER u.age >= @min OR u.name == @name FILTER u.age < @max LIMIT 10 RETURN u.`first name`",
+			bindVars: map[string]interface{}{"@col": "users", "min": 18, "name": "admin", "max": 65},
+		},
+		"same parameter used twice": {
+			query: New().
+				For("u", Collection("users")).
+				Filter(Or(Compare(u.Attr("a"), "==", Param("x", 1)), Expr("u.b == @x", Param("x", 1)))).
+				Return(u),
+			text:     "FOR u IN @@_c0 FILTER (u.a == @x OR (u.b == @x)) RETURN u",
+			bindVars: map[string]interface{}{"@_c0": "users", "x": 1},
+		},
+		"collect": {
+			query: New().
+				For("u", Collection("users")).
+				CollectAggregate(
+					[]Assignment{Assign("city", u.Attr("city"))},
+					[]Assignment{Assign("total", Func("SUM", u.Attr("age")))}).
+				Return(Expr("{city, total}")),
+			text:     "FOR u IN @@_c0 COLLECT city = u.city AGGREGATE total = SUM(u.age) RETURN {city, total}",
+			bindVars: map[string]interface{}{"@_c0": "users"},
+		},
+		"collect with count": {
+			query: New().
+				For("u", Collection("users")).
+				CollectWithCount("length").
+				Return(Var("length")),
+			text:     "FOR u IN @@_c0 COLLECT WITH COUNT INTO length RETURN length",
+			bindVars: map[string]interface{}{"@_c0": "users"},
+		},
+		"nested subquery": {
+			query: New().
+				For("u", Collection("users")).
+				Let("orders", Subquery(New().
+					For("o", Collection("orders")).
+					Filter(Compare(Var("o").Attr("user"), "==", u.Attr("_key"))).
+					Filter(Compare(Var("o").Attr("total"), ">", Value(100))).
+					Return(Var("o")))).
+				Filter(Compare(Func("LENGTH", Var("orders")), ">", Value(0))).
+				Return(Expr("MERGE(u, {orders})")),
+			text: "FOR u IN @@_c0 LET orders = (FOR o IN @@_c1 FILTER o.user == u._key FILTER o.total > @_v2 RETURN o) " +
+				"FILTER LENGTH(orders) > @_v3 RETURN MERGE(u, {orders})",
+			bindVars: map[string]interface{}{"@_c0": "users", "@_c1": "orders", "_v2": 100, "_v3": 0},
+		},
+		"raw statement": {
+			query: New().
+				For("i", Func("RANGE", Value(1), Value(3))).
+				Raw("INSERT {value: i, source: @source} INTO @@col", Param("source", "test"), CollectionParam("col", "items")),
+			text:     "FOR i IN RANGE(@_v0, @_v1) INSERT {value: i, source: @source} INTO @@col",
+			bindVars: map[string]interface{}{"_v0": 1, "_v1": 3, "source": "test", "@col": "items"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			text, bindVars, err := tc.query.Build()
+			require.NoError(t, err)
+			assert.Equal(t, tc.text, text)
+			assert.Equal(t, tc.bindVars, bindVars)
+			assert.Equal(t, tc.text, tc.query.String())
+		})
+	}
+}
+
+func TestQuery_BuildErrors(t *testing.T) {
+	testCases := map[string]struct {
+		query    *Query
+		expected string
+	}{
+		"empty": {
+			query:    New(),
+			expected: "query is empty",
+		},
+		"missing parameter": {
+			query:    New().For("u", Collection("users")).Filter(Expr("u.age > @age")).Return(Var("u")),
+			expected: "missing bind parameters: @age",
+		},
+		"unused parameter": {
+			query:    New().For("u", Collection("users")).Filter(Expr("u.age > 18", Param("age", 18))).Return(Var("u")),
+			expected: "unused bind parameters: @age",
+		},
+		"conflicting parameter": {
+			query: New().For("u", Collection("users")).
+				Filter(Compare(Var("u").Attr("a"), "==", Param("x", 1))).
+				Filter(Compare(Var("u").Attr("b"), "==", Param("x", 2))).
+				Return(Var("u")),
+			expected: "bind parameter @x is bound to different values: 1 and 2",
+		},
+		"reserved parameter name": {
+			query:    New().Return(Param("_v0", 1)),
+			expected: "invalid bind parameter name '_v0'",
+		},
+		"invalid variable": {
+			query:    New().For("u; REMOVE", Collection("users")).Return(Var("u")),
+			expected: "invalid variable name 'u; REMOVE'",
+		},
+		"invalid operator": {
+			query:    New().Return(Compare(Value(1), "== 1 OR", Value(2))),
+			expected: "invalid comparison operator '== 1 OR'",
+		},
+		"invalid function": {
+			query:    New().Return(Func("LENGTH(1)", Value(1))),
+			expected: "invalid function name 'LENGTH(1)'",
+		},
+		"empty collection": {
+			query:    New().For("u", Collection("")).Return(Var("u")),
+			expected: "collection name is empty",
+		},
+		"negative limit": {
+			query:    New().For("u", Collection("users")).Limit(-1).Return(Var("u")),
+			expected: "invalid LIMIT 0, -1",
+		},
+		"empty collect": {
+			query:    New().For("u", Collection("users")).Collect().Return(Var("u")),
+			expected: "COLLECT requires groups, aggregates or a count",
+		},
+		"nil operand": {
+			query:    New().Return(nil),
+			expected: "operand is nil",
+		},
+		"empty subquery": {
+			query:    New().Let("x", Subquery(New())).Return(Var("x")),
+			expected: "query is empty",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := tc.query.Build()
+			require.Error(t, err)
+			assert.True(t, shared.IsInvalidArgument(err))
+			assert.Equal(t, tc.expected, err.Error())
+			assert.Empty(t, tc.query.String())
+		})
+	}
+}
+
+func TestQuery_QueryOptions(t *testing.T) {
+	q := New().For("u", Collection("users")).Filter(Expr("u.age > @age")).Return(Var("u"))
+
+	opts := &arangodb.QueryOptions{Count: true, BindVars: map[string]interface{}{"age": 18}}
+	text, queryOpts, err := q.QueryOptions(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "FOR u IN @@_c0 FILTER u.age > @age RETURN u", text)
+	assert.True(t, queryOpts.Count)
+	assert.Equal(t, map[string]interface{}{"@_c0": "users", "age": 18}, queryOpts.BindVars)
+	assert.Equal(t, map[string]interface{}{"age": 18}, opts.BindVars, "options of the caller must not be changed")
+
+	_, _, err = q.QueryOptions(nil)
+	require.Error(t, err)
+	assert.Equal(t, "missing bind parameters: @age", err.Error())
+
+	_, _, err = q.QueryOptions(&arangodb.QueryOptions{BindVars: map[string]interface{}{"age": 18, "other": 1}})
+	require.Error(t, err)
+	assert.Equal(t, "unused bind parameters: @other", err.Error())
+}
@@ -0,0 +1,118 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"time"
+)
+
+// BulkLoader writes a stream of documents into a collection using parallel workers.
+// The stream is split into batches, which are retried with an exponential backoff when the server is overloaded.
+// The loader keeps track of the committed offset, i.e. the number of documents at the beginning of the stream which
+// have all been written, so an interrupted load can be resumed with BulkLoaderOptions.Offset.
+//
+// Batches which are not committed when a load is interrupted (e.g. because of a network error) may have been
+// written partially. Give the documents a `_key` and use ImportOnDuplicateIgnore (or overwrite mode ignore when
+// creating documents) to resume without duplicates.
+type BulkLoader interface {
+	// Load reads the documents from the source and writes them into the collection.
+	// The first BulkLoaderOptions.Offset documents of the source are skipped.
+	// It returns when the source is exhausted, the context is cancelled or a batch can not be written.
+	// The returned progress holds the committed offset also when an error is returned.
+	Load(ctx context.Context, source ImportSource) (BulkLoaderProgress, error)
+}
+
+// BulkLoaderMethod describes how the batches are written into the collection.
+type BulkLoaderMethod string
+
+const (
+	// BulkLoaderMethodImport writes the batches with the import API. This is the default.
+	// https://www.arangodb.com/docs/stable/http/bulk-imports.html
+	BulkLoaderMethodImport BulkLoaderMethod = "import"
+	// BulkLoaderMethodCreate writes the batches with the multiple documents create API.
+	// It supports only sources of ImportTypeDocuments.
+	// https://www.arangodb.com/docs/stable/http/document.html#create-multiple-documents
+	BulkLoaderMethodCreate BulkLoaderMethod = "create"
+)
+
+type BulkLoaderOptions struct {
+	// Method describes how the batches are written. The default is BulkLoaderMethodImport.
+	Method BulkLoaderMethod
+
+	// ImportOptions are used for each batch written with BulkLoaderMethodImport.
+	// Overwrite, ChunkSize, ChunkBytes and Concurrency are ignored.
+	ImportOptions *CollectionDocumentImportOptions
+
+	// CreateOptions are used for each batch written with BulkLoaderMethodCreate.
+	// NewObject and OldObject are ignored. Silent can be set to reduce the size of the responses,
+	// the created documents are counted in both cases.
+	CreateOptions *CollectionDocumentCreateOptions
+
+	// BatchSize is the maximum number of documents in a batch. The default is 1000.
+	BatchSize int
+
+	// BatchBytes is the maximum size of a batch in bytes. The default is no limit.
+	BatchBytes int
+
+	// Workers is the number of batches which are written at the same time. The default is 4.
+	Workers int
+
+	// Offset is the number of documents at the beginning of the source which are skipped,
+	// e.g. BulkLoaderProgress.Offset of an interrupted load.
+	Offset int64
+
+	// MaxRetries is the maximum number of retries of a batch which is rejected because the server is overloaded,
+	// or which is lost because of a network error.
+	// The default is 10.
+	MaxRetries int
+
+	// RetryInterval is the initial backoff before a rejected batch is retried. It doubles with each retry.
+	// The default is 500ms.
+	RetryInterval time.Duration
+
+	// MaxRetryInterval is the maximum backoff before a retry. The default is 30s.
+	MaxRetryInterval time.Duration
+
+	// MaxQueueTime is the server queue time above which the workers pause before sending the next batch.
+	// The queue time is reported by the server in the `x-arango-queue-time-seconds` header. The default is 1s.
+	MaxQueueTime time.Duration
+
+	// OnProgress is called after each written batch. The calls are not concurrent.
+	OnProgress func(progress BulkLoaderProgress)
+}
+
+// BulkLoaderProgress describes the progress of a load.
+type BulkLoaderProgress struct {
+	ImportDocumentStatistics
+
+	// Offset is the committed offset. All documents of the source before the offset have been written.
+	Offset int64
+
+	// Batches holds the number of written batches.
+	Batches int
+
+	// Retries holds the number of retried batches.
+	Retries int
+
+	// QueueTime is the last server queue time reported by the server.
+	QueueTime time.Duration
+}
@@ -0,0 +1,365 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+const (
+	defaultBulkLoaderBatchSize        = 1000
+	defaultBulkLoaderWorkers          = 4
+	defaultBulkLoaderMaxRetries       = 10
+	defaultBulkLoaderRetryInterval    = 500 * time.Millisecond
+	defaultBulkLoaderMaxRetryInterval = 30 * time.Second
+	defaultBulkLoaderMaxQueueTime     = time.Second
+
+	// headerQueueTime is the header with the current queue time of the server in seconds.
+	headerQueueTime = "x-arango-queue-time-seconds"
+	// errorNumQueueTimeViolated is returned when a request has been queued longer than the client accepts.
+	errorNumQueueTimeViolated = 21
+)
+
+// NewBulkLoader creates a BulkLoader which writes the documents into the given collection.
+// The requests are sent with the request modifiers of the collection and of its database.
+// The collection must be created by the driver, because the loader reads the queue time from the responses,
+// which are not returned by the methods of the Collection interface.
+func NewBulkLoader(col Collection, opts *BulkLoaderOptions) (BulkLoader, error) {
+	c, ok := col.(*collection)
+	if !ok {
+		return nil, errors.WithStack(shared.InvalidArgumentError{Message: "bulk loader collection must be created by the driver"})
+	}
+
+	// The batches are sent with the connection and the modifiers of the collection.
+	l := &bulkLoader{collection: c}
+
+	if opts != nil {
+		l.options = *opts
+	}
+
+	if l.options.Method == "" {
+		l.options.Method = BulkLoaderMethodImport
+	}
+	if l.options.BatchSize <= 0 {
+		l.options.BatchSize = defaultBulkLoaderBatchSize
+	}
+	if l.options.Workers <= 0 {
+		l.options.Workers = defaultBulkLoaderWorkers
+	}
+	if l.options.MaxRetries <= 0 {
+		l.options.MaxRetries = defaultBulkLoaderMaxRetries
+	}
+	if l.options.RetryInterval <= 0 {
+		l.options.RetryInterval = defaultBulkLoaderRetryInterval
+	}
+	if l.options.MaxRetryInterval <= 0 {
+		l.options.MaxRetryInterval = defaultBulkLoaderMaxRetryInterval
+	}
+	if l.options.MaxQueueTime <= 0 {
+		l.options.MaxQueueTime = defaultBulkLoaderMaxQueueTime
+	}
+	if l.options.CreateOptions != nil {
+		// The created documents are only counted, so they are never returned.
+		createOptions := *l.options.CreateOptions
+		createOptions.NewObject = nil
+		createOptions.OldObject = nil
+		l.options.CreateOptions = &createOptions
+	}
+
+	return l, nil
+}
+
+var _ BulkLoader = &bulkLoader{}
+
+type bulkLoader struct {
+	collection *collection
+	options    BulkLoaderOptions
+}
+
+func (l *bulkLoader) Load(ctx context.Context, source ImportSource) (BulkLoaderProgress, error) {
+	load := &bulkLoad{
+		progress: BulkLoaderProgress{Offset: l.options.Offset},
+		written:  map[int64]int64{},
+	}
+
+	if source == nil {
+		return load.progress, errors.WithStack(shared.InvalidArgumentError{Message: "bulk loader source must be set"})
+	}
+
+	switch t := source.Type(); {
+	case t != ImportTypeDocuments && t != ImportTypeArrays:
+		return load.progress, errors.WithStack(shared.InvalidArgumentError{Message: "unsupported bulk loader source type: " + string(t)})
+	case t != ImportTypeDocuments && l.options.Method == BulkLoaderMethodCreate:
+		return load.progress, errors.WithStack(shared.InvalidArgumentError{Message: "documents can be created only from a source of documents"})
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		firstErr error
+		batches  = make(chan importChunk)
+	)
+
+	fail := func(err error) {
+		load.lock.Lock()
+		defer load.lock.Unlock()
+
+		if firstErr == nil {
+			firstErr = err
+		}
+		cancel()
+	}
+
+	for i := 0; i < l.options.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for batch := range batches {
+				if err := l.writeBatch(ctx, load, source.Type(), batch); err != nil {
+					fail(err)
+					return
+				}
+			}
+		}()
+	}
+
+	err := splitImportSource(ctx, source, l.options.Offset, l.options.BatchSize, l.options.BatchBytes, batches)
+	close(batches)
+	if err != nil {
+		fail(err)
+	}
+	wg.Wait()
+
+	load.lock.Lock()
+	defer load.lock.Unlock()
+
+	return load.progress, firstErr
+}
+
+// writeBatch writes the batch and retries it as long as the server is overloaded.
+func (l *bulkLoader) writeBatch(ctx context.Context, load *bulkLoad, importType ImportType, batch importChunk) error {
+	for retries := 0; ; retries++ {
+		if err := load.wait(ctx); err != nil {
+			return err
+		}
+
+		resp, stats, err := l.write(ctx, importType, batch)
+
+		queueTime := parseQueueTime(resp)
+		if queueTime > l.options.MaxQueueTime {
+			// The server is busy, so all workers slow down.
+			load.pause(minDuration(queueTime, l.options.MaxRetryInterval))
+		}
+
+		if err == nil {
+			load.commit(batch, stats, retries, queueTime, l.options.OnProgress)
+			return nil
+		}
+
+		if !isBulkLoaderRetryable(err) || retries >= l.options.MaxRetries {
+			return err
+		}
+
+		load.pause(l.backoff(retries))
+	}
+}
+
+// write sends the batch to the server with the configured method.
+func (l *bulkLoader) write(ctx context.Context, importType ImportType, batch importChunk) (connection.Response, ImportDocumentStatistics, error) {
+	c := l.collection
+
+	if l.options.Method != BulkLoaderMethodCreate {
+		resp, stats, _, err := sendImportChunk(ctx, c.connection(), c.db.url("_api", "import"), c.name, importType, batch,
+			l.options.ImportOptions, c.withModifiers()...)
+		return resp, stats, err
+	}
+
+	// The documents are already encoded as JSON lines, so they only need to be joined into an array.
+	body := make([]byte, 0, len(batch.body)+1)
+	body = append(body, '[')
+	body = append(body, bytes.ReplaceAll(bytes.TrimSuffix(batch.body, []byte{'\n'}), []byte{'\n'}, []byte{','})...)
+	body = append(body, ']')
+
+	var response byteDecoder
+
+	resp, err := connection.CallPost(ctx, c.connection(), c.url("document"), &response, body,
+		c.withModifiers(withEncodedBody, l.options.CreateOptions.modifyRequest)...)
+	if err != nil {
+		return nil, ImportDocumentStatistics{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated, http.StatusAccepted:
+		var results []shared.ResponseStruct
+		if err := response.Unmarshal(&results); err != nil {
+			return resp, ImportDocumentStatistics{}, errors.WithStack(err)
+		}
+
+		var stats ImportDocumentStatistics
+		for _, result := range results {
+			if result.Error != nil && *result.Error {
+				stats.Errors++
+			}
+		}
+		// The created documents are counted from the batch, because with CreateOptions.Silent
+		// only the failed documents are returned.
+		stats.Created = int64(batch.count) - stats.Errors
+
+		return resp, stats, nil
+	default:
+		return resp, ImportDocumentStatistics{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+// backoff returns the time to wait before the given retry.
+func (l *bulkLoader) backoff(retry int) time.Duration {
+	d := l.options.RetryInterval
+	for i := 0; i < retry && d < l.options.MaxRetryInterval; i++ {
+		d *= 2
+	}
+
+	return minDuration(d, l.options.MaxRetryInterval)
+}
+
+// bulkLoad holds the state of a single load shared by the workers.
+type bulkLoad struct {
+	lock     sync.Mutex
+	progress BulkLoaderProgress
+
+	// written maps the offsets of batches written after the committed offset to their ends.
+	written map[int64]int64
+
+	pausedUntil time.Time
+
+	// reportLock serializes the calls of OnProgress, which are made without holding lock.
+	reportLock sync.Mutex
+}
+
+// commit records the written batch and moves the committed offset over all contiguous written batches.
+// The copy of the progress is passed to onProgress after the lock is released.
+func (b *bulkLoad) commit(batch importChunk, stats ImportDocumentStatistics, retries int, queueTime time.Duration,
+	onProgress func(progress BulkLoaderProgress)) {
+	b.lock.Lock()
+
+	b.progress.Created += stats.Created
+	b.progress.Errors += stats.Errors
+	b.progress.Empty += stats.Empty
+	b.progress.Updated += stats.Updated
+	b.progress.Ignored += stats.Ignored
+	b.progress.Batches++
+	b.progress.Retries += retries
+	b.progress.QueueTime = queueTime
+
+	b.written[batch.offset] = batch.offset + int64(batch.count)
+	for {
+		end, ok := b.written[b.progress.Offset]
+		if !ok {
+			break
+		}
+		delete(b.written, b.progress.Offset)
+		b.progress.Offset = end
+	}
+
+	progress := b.progress
+	if onProgress == nil {
+		b.lock.Unlock()
+		return
+	}
+
+	// The report lock is taken before the lock is released, so the progress is reported in the order of the commits.
+	b.reportLock.Lock()
+	defer b.reportLock.Unlock()
+	b.lock.Unlock()
+
+	onProgress(progress)
+}
+
+// pause makes all workers wait for the given time before sending the next batch.
+func (b *bulkLoad) pause(d time.Duration) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if until := time.Now().Add(d); until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
+
+// wait blocks until the load is not paused.
+func (b *bulkLoad) wait(ctx context.Context) error {
+	b.lock.Lock()
+	d := time.Until(b.pausedUntil)
+	b.lock.Unlock()
+
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isBulkLoaderRetryable returns true when the server rejected the request because it is overloaded.
+func isBulkLoaderRetryable(err error) bool {
+	return connection.IsNetworkError(err) ||
+		shared.IsArangoErrorWithCode(err, http.StatusServiceUnavailable) ||
+		shared.IsArangoErrorWithErrorNum(err, errorNumQueueTimeViolated)
+}
+
+// parseQueueTime returns the queue time reported in the response.
+func parseQueueTime(resp connection.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	seconds, err := strconv.ParseFloat(resp.Header(headerQueueTime), 64)
+	if err != nil {
+		return 0
+	}
+
+	return time.Duration(seconds * float64(time.Second))
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
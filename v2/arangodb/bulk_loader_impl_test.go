@@ -0,0 +1,252 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// newBulkLoaderTestServer returns the server which accepts documents for the collection "users" in the database "db".
+// The handler decides about the response for each batch of keys, it returns 0 to accept the batch
+// and -1 to close the connection.
+func newBulkLoaderTestServer(t *testing.T, handler func(keys []string) int) (*httptest.Server, *sync.Map) {
+	var written sync.Map
+
+	respond := func(w http.ResponseWriter, keys []string, body map[string]interface{}) {
+		w.Header().Set(headerQueueTime, "0.001")
+
+		switch code := handler(keys); code {
+		case 0:
+		case -1:
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+			return
+		default:
+			writeTestError(w, code, 1)
+			return
+		}
+
+		for _, key := range keys {
+			_, loaded := written.LoadOrStore(key, true)
+			assert.False(t, loaded, "document %s is written twice", key)
+		}
+
+		if body == nil {
+			writeTestResponse(w, http.StatusCreated, make([]map[string]interface{}, len(keys)))
+			return
+		}
+		writeTestResponse(w, http.StatusCreated, body)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/_db/db/_api/import", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "users", r.URL.Query().Get("collection"))
+		assert.Equal(t, "trx", r.Header.Get("x-arango-trx-id"))
+
+		var keys []string
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var doc struct {
+				Key string `json:"_key"`
+			}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &doc))
+			keys = append(keys, doc.Key)
+		}
+
+		respond(w, keys, map[string]interface{}{"error": false, "created": len(keys)})
+	})
+	mux.HandleFunc("/_db/db/_api/document/users", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "trx", r.Header.Get("x-arango-trx-id"))
+		assert.Equal(t, connection.PlainText, r.Header.Get(connection.ContentType))
+
+		var docs []struct {
+			Key string `json:"_key"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&docs))
+
+		var keys []string
+		for _, doc := range docs {
+			keys = append(keys, doc.Key)
+		}
+
+		respond(w, keys, nil)
+	})
+
+	return newTestServer(t, mux), &written
+}
+
+// newBulkLoaderTestCollection returns the collection "users" in the database "db", which is used in a transaction.
+func newBulkLoaderTestCollection(url string) Collection {
+	return newCollection(newDatabase(newTestClient(url), "db", connection.WithTransactionID("trx")), "users")
+}
+
+func newBulkLoaderTestSource(n int) ImportSource {
+	var input bytes.Buffer
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&input, "{\"_key\":\"%03d\"}\n", i)
+	}
+
+	return NewImportSourceReader(&input, ImportTypeDocuments)
+}
+
+func countWritten(written *sync.Map) int {
+	var count int
+	written.Range(func(_, _ interface{}) bool {
+		count++
+		return true
+	})
+
+	return count
+}
+
+func TestBulkLoader_Load(t *testing.T) {
+	for _, method := range []BulkLoaderMethod{BulkLoaderMethodImport, BulkLoaderMethodCreate} {
+		t.Run(string(method), func(t *testing.T) {
+			var requests int32
+			server, written := newBulkLoaderTestServer(t, func(keys []string) int {
+				// The first batches are lost or rejected like by an overloaded server.
+				switch atomic.AddInt32(&requests, 1) {
+				case 1:
+					return -1
+				case 2:
+					return http.StatusServiceUnavailable
+				}
+				return 0
+			})
+
+			var offsets []int64
+			loader, err := NewBulkLoader(newBulkLoaderTestCollection(server.URL), &BulkLoaderOptions{
+				Method:        method,
+				BatchSize:     10,
+				Workers:       3,
+				RetryInterval: time.Millisecond,
+				OnProgress: func(progress BulkLoaderProgress) {
+					offsets = append(offsets, progress.Offset)
+				},
+			})
+			require.NoError(t, err)
+
+			progress, err := loader.Load(context.Background(), newBulkLoaderTestSource(95))
+			require.NoError(t, err)
+
+			assert.Equal(t, int64(95), progress.Offset)
+			assert.Equal(t, int64(95), progress.Created)
+			assert.Equal(t, 10, progress.Batches)
+			assert.Equal(t, 2, progress.Retries)
+			assert.Equal(t, time.Millisecond, progress.QueueTime)
+			assert.Equal(t, 95, countWritten(written))
+
+			require.Len(t, offsets, 10)
+			for i := 1; i < len(offsets); i++ {
+				assert.LessOrEqual(t, offsets[i-1], offsets[i])
+			}
+			assert.Equal(t, int64(95), offsets[9])
+		})
+	}
+}
+
+func TestBulkLoader_Resume(t *testing.T) {
+	failed := int32(0)
+	server, written := newBulkLoaderTestServer(t, func(keys []string) int {
+		// The batch with the document 045 fails once, so the load stops before it.
+		for _, key := range keys {
+			if key == "045" && atomic.CompareAndSwapInt32(&failed, 0, 1) {
+				return http.StatusBadRequest
+			}
+		}
+		return 0
+	})
+
+	col := newBulkLoaderTestCollection(server.URL)
+	opts := BulkLoaderOptions{BatchSize: 10, Workers: 1}
+
+	loader, err := NewBulkLoader(col, &opts)
+	require.NoError(t, err)
+
+	progress, err := loader.Load(context.Background(), newBulkLoaderTestSource(95))
+	require.Error(t, err)
+	require.True(t, shared.IsArangoErrorWithCode(err, http.StatusBadRequest))
+	assert.Equal(t, int64(40), progress.Offset)
+	assert.Equal(t, 40, countWritten(written))
+
+	opts.Offset = progress.Offset
+	loader, err = NewBulkLoader(col, &opts)
+	require.NoError(t, err)
+
+	progress, err = loader.Load(context.Background(), newBulkLoaderTestSource(95))
+	require.NoError(t, err)
+	assert.Equal(t, int64(95), progress.Offset)
+	assert.Equal(t, int64(55), progress.Created)
+	assert.Equal(t, 95, countWritten(written))
+}
+
+func TestBulkLoader_InvalidSource(t *testing.T) {
+	loader, err := NewBulkLoader(newBulkLoaderTestCollection("http://localhost"), &BulkLoaderOptions{Method: BulkLoaderMethodCreate})
+	require.NoError(t, err)
+
+	_, err = loader.Load(context.Background(), NewImportSourceReader(strings.NewReader("[\"_key\"]\n"), ImportTypeArrays))
+	require.True(t, shared.IsInvalidArgument(err))
+}
+
+func TestNewBulkLoader_InvalidCollection(t *testing.T) {
+	_, err := NewBulkLoader(nil, nil)
+	require.True(t, shared.IsInvalidArgument(err))
+}
+
+func TestBulkLoad_Commit(t *testing.T) {
+	load := &bulkLoad{progress: BulkLoaderProgress{Offset: 10}, written: map[int64]int64{}}
+
+	load.commit(importChunk{offset: 20, count: 10}, ImportDocumentStatistics{Created: 10}, 0, 0, nil)
+	assert.Equal(t, int64(10), load.progress.Offset)
+
+	load.commit(importChunk{offset: 10, count: 10}, ImportDocumentStatistics{Created: 8, Errors: 2}, 1, 0, nil)
+	assert.Equal(t, int64(30), load.progress.Offset)
+	assert.Equal(t, int64(18), load.progress.Created)
+	assert.Equal(t, int64(2), load.progress.Errors)
+	assert.Equal(t, 1, load.progress.Retries)
+	assert.Empty(t, load.written)
+
+	// The progress is reported without holding the lock of the load.
+	var reported BulkLoaderProgress
+	load.commit(importChunk{offset: 30, count: 10}, ImportDocumentStatistics{Created: 10}, 0, 0, func(progress BulkLoaderProgress) {
+		load.pause(0)
+		reported = progress
+	})
+	assert.Equal(t, int64(40), reported.Offset)
+}
@@ -0,0 +1,134 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ChangeFeed consumes the write-ahead log of a database (WAL tailing) as a stream of change events.
+// Events are delivered at least once: after a restart, the feed continues from the last tick saved
+// in the checkpoint store, so the events which were handled after the last checkpoint are delivered again.
+// The write-ahead log can be read only from a single server or from a DB-Server, not through a coordinator.
+// After a failed request, e.g. when the server has failed over, the feed continues from the last saved tick,
+// which can be read from the log of another server only when the tick is still present in it.
+// Otherwise, the feed stops with ChangeFeedGapError, unless ChangeFeedOptions.OnGap is set.
+// https://www.arangodb.com/docs/stable/http/replications-wal-access.html
+type ChangeFeed interface {
+	// Run consumes the change feed and calls the handler for each event until the context is canceled,
+	// the handler returns an error or an unrecoverable error occurs.
+	// Network errors and temporarily unavailable servers (e.g. during a restart or a failover) are retried.
+	// Run must not be called concurrently.
+	Run(ctx context.Context, handler ChangeFeedHandler) error
+
+	// Events consumes the change feed in the background and sends the events to the returned channel.
+	// The error which stops the feed is sent to the error channel. Both channels are closed when the feed stops.
+	// The ticks are saved in the checkpoint store once the events have been received from the channel.
+	Events(ctx context.Context) (<-chan ChangeFeedEvent, <-chan error)
+
+	// Tick returns the tick of the last event handled by the feed.
+	Tick() Tick
+}
+
+// ChangeFeedHandler handles a single change event.
+// When it returns an error, the change feed stops and the event is delivered again after the restart.
+type ChangeFeedHandler func(ctx context.Context, event ChangeFeedEvent) error
+
+// ChangeFeedCheckpointStore persists the tick from which the change feed continues after a restart.
+type ChangeFeedCheckpointStore interface {
+	// Load returns the saved tick, or an empty tick when no tick has been saved.
+	Load(ctx context.Context) (Tick, error)
+	// Save saves the tick of the last handled event.
+	Save(ctx context.Context, tick Tick) error
+}
+
+type ChangeFeedOptions struct {
+	// From is the tick after which the feed starts.
+	// If it is empty, the tick from the checkpoint store is used.
+	// If the checkpoint store is empty too, the feed starts from the current state of the replication logger,
+	// so only new changes are returned.
+	From Tick
+	// Collections restricts the events to the given collections.
+	// If it is empty, the events of all collections and the database-level events are returned.
+	Collections []string
+	// CheckpointStore persists the tick of the last handled event.
+	// If it is nil, the tick is kept only in the memory.
+	CheckpointStore ChangeFeedCheckpointStore
+	// PollInterval is the time to wait for new changes when the log has been read completely.
+	// Default is 1 second.
+	PollInterval time.Duration
+	// RetryInterval is the time to wait before a failed request is retried.
+	// Default is 1 second.
+	RetryInterval time.Duration
+	// MaxRetries is the maximum number of consecutive failed requests, after which the feed stops.
+	// Zero means that requests are retried until the context is canceled.
+	MaxRetries int
+	// OnRetry is called when a failed request is going to be retried.
+	OnRetry func(err error, attempt int)
+	// OnGap is called when the tick, from which the feed continues, is no longer present in the log,
+	// so some changes may have been lost. When it returns nil, the feed continues with the oldest available changes.
+	// If it is nil, the feed stops with ChangeFeedGapError.
+	OnGap func(from Tick) error
+	// ChunkSize is the approximate maximum size of a single response in bytes.
+	ChunkSize int
+	// SyncerID is the ID of the client, which is reported to the server.
+	SyncerID string
+	// ClientInfo is a short description of the client, which is reported to the server.
+	ClientInfo string
+}
+
+// ChangeFeedEvent is a single change in the database.
+// The write-ahead log does not distinguish between the insertion and the update of a document,
+// both are reported with the ReplicationLogEntryTypeDocumentInsertOrReplace type.
+type ChangeFeedEvent struct {
+	ReplicationLogEntry
+
+	// Collection is the name of the collection, when the operation concerns a collection.
+	Collection string
+	// Key of the document, for the document operations.
+	Key string
+	// Rev is the revision of the document, for the document operations.
+	Rev string
+}
+
+// Unmarshal unmarshalls the data of the operation (e.g. the document) into the given object.
+func (e ChangeFeedEvent) Unmarshal(i interface{}) error {
+	return json.Unmarshal(e.Data, i)
+}
+
+// IsDocumentOperation returns true if the event concerns a single document.
+func (e ChangeFeedEvent) IsDocumentOperation() bool {
+	return e.Type == ReplicationLogEntryTypeDocumentInsertOrReplace || e.Type == ReplicationLogEntryTypeDocumentRemove
+}
+
+// ChangeFeedGapError is returned when the tick, from which the change feed continues,
+// is no longer present in the write-ahead log.
+type ChangeFeedGapError struct {
+	From Tick
+}
+
+// Error implements the error interface for ChangeFeedGapError.
+func (e ChangeFeedGapError) Error() string {
+	return fmt.Sprintf("tick %s is no longer present in the write-ahead log", e.From)
+}
@@ -0,0 +1,486 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// NewChangeFeed returns the change feed of the given database.
+func NewChangeFeed(client Client, dbName string, opts *ChangeFeedOptions) ChangeFeed {
+	c := &changeFeed{
+		client:      client,
+		dbName:      dbName,
+		collections: map[string]string{},
+	}
+
+	if opts != nil {
+		c.options = *opts
+	}
+
+	if c.options.CheckpointStore == nil {
+		c.options.CheckpointStore = NewChangeFeedMemoryCheckpointStore()
+	}
+
+	// The checkpoints are written to the log too. They are skipped, otherwise each saved checkpoint
+	// would be followed by an event, which would be followed by another checkpoint.
+	if store, ok := c.options.CheckpointStore.(*changeFeedCollectionCheckpointStore); ok &&
+		store.col.Database().Name() == dbName {
+		c.checkpointCollection = store.col.Name()
+	}
+
+	if len(c.options.Collections) > 0 {
+		c.filter = map[string]bool{}
+		for _, name := range c.options.Collections {
+			c.filter[name] = true
+		}
+	}
+
+	return c
+}
+
+var _ ChangeFeed = &changeFeed{}
+
+type changeFeed struct {
+	client  Client
+	dbName  string
+	options ChangeFeedOptions
+	filter  map[string]bool
+
+	// checkpointCollection is the name of the collection of the checkpoint store, if it is in the database.
+	checkpointCollection string
+
+	// collections maps globally unique IDs of the collections to their names.
+	collections map[string]string
+
+	lock sync.Mutex
+	tick Tick
+}
+
+// changeFeedHandlerError wraps the errors returned by the handler, so they are never retried.
+type changeFeedHandlerError struct {
+	err error
+}
+
+func (e changeFeedHandlerError) Error() string {
+	return e.err.Error()
+}
+
+func (c *changeFeed) Tick() Tick {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.tick
+}
+
+func (c *changeFeed) setTick(tick Tick) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.tick = tick
+}
+
+func (c *changeFeed) Events(ctx context.Context) (<-chan ChangeFeedEvent, <-chan error) {
+	events := make(chan ChangeFeedEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(events)
+
+		err := c.Run(ctx, func(ctx context.Context, event ChangeFeedEvent) error {
+			select {
+			case events <- event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return events, errs
+}
+
+func (c *changeFeed) Run(ctx context.Context, handler ChangeFeedHandler) error {
+	from, err := c.startTick(ctx)
+	if err != nil {
+		return err
+	}
+	c.setTick(from)
+
+	if err := c.loadCollections(ctx); err != nil {
+		return err
+	}
+
+	// checkpoint is the last saved tick, from which the feed resumes after a failed request.
+	checkpoint := from
+	var lastScanned Tick
+	failures := 0
+	reconnect := false
+
+	for {
+		var state ReplicationLogState
+		var err error
+		if reconnect {
+			// The failed request may have been caused by a failover, so the next request can be sent to another server.
+			// Its log is read from the checkpointed tick, and the names of the collections are loaded from its inventory.
+			// When the tick is no longer present in its log, the feed stops with ChangeFeedGapError, unless OnGap is set.
+			// The last scanned tick is valid only for the server which has returned it.
+			from, lastScanned = checkpoint, ""
+			err = c.loadCollections(ctx)
+		}
+
+		if err == nil {
+			reconnect = false
+
+			var next Tick
+			var changed bool
+			state, next, changed, err = c.follow(ctx, from, lastScanned, handler)
+			if next != from {
+				from = next
+				// When the request has failed, its error is returned instead, and the tick is saved with the next events.
+				// The tick is not saved when only the previous checkpoints have been read.
+				if changed {
+					if saveErr := c.options.CheckpointStore.Save(ctx, from); saveErr != nil {
+						if err == nil {
+							return errors.WithStack(saveErr)
+						}
+					} else {
+						checkpoint = from
+					}
+				}
+			}
+		}
+
+		if err != nil {
+			if handlerErr, ok := err.(changeFeedHandlerError); ok {
+				return handlerErr.err
+			}
+
+			if ctx.Err() != nil || !isChangeFeedRetryable(err) {
+				return err
+			}
+
+			failures++
+			if c.options.MaxRetries > 0 && failures > c.options.MaxRetries {
+				return err
+			}
+
+			if c.options.OnRetry != nil {
+				c.options.OnRetry(err, failures)
+			}
+
+			reconnect = true
+			if err := c.wait(ctx, c.options.retryInterval()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		failures = 0
+		lastScanned = state.LastScanned
+
+		if !state.CheckMore {
+			if err := c.wait(ctx, c.options.pollInterval()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// startTick returns the tick after which the feed starts.
+func (c *changeFeed) startTick(ctx context.Context) (Tick, error) {
+	if c.options.From != "" {
+		return c.options.From, nil
+	}
+
+	tick, err := c.options.CheckpointStore.Load(ctx)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if tick != "" {
+		return tick, nil
+	}
+
+	state, err := c.client.LoggerState(ctx, c.dbName)
+	if err != nil {
+		return "", err
+	}
+
+	return Tick(state.State.LastLogTick), nil
+}
+
+// follow reads a single response from the log and passes its events to the handler.
+// It returns the tick from which the next request continues, and false when the response
+// contains only the checkpoints of the feed.
+func (c *changeFeed) follow(ctx context.Context, from, lastScanned Tick,
+	handler ChangeFeedHandler) (ReplicationLogState, Tick, bool, error) {
+	reader, err := c.client.LoggerFollow(ctx, c.dbName, &LoggerFollowOptions{
+		From:        from,
+		LastScanned: lastScanned,
+		ChunkSize:   c.options.ChunkSize,
+		SyncerID:    c.options.SyncerID,
+		ClientInfo:  c.options.ClientInfo,
+	})
+	if err != nil {
+		return ReplicationLogState{}, from, true, err
+	}
+	defer reader.Close()
+
+	state := reader.State()
+	if !state.FromPresent && from != "" && from != "0" {
+		if c.options.OnGap == nil {
+			return state, from, true, ChangeFeedGapError{From: from}
+		}
+
+		if err := c.options.OnGap(from); err != nil {
+			return state, from, true, changeFeedHandlerError{err: err}
+		}
+	}
+
+	entries, checkpoints := 0, 0
+	for {
+		entry, err := reader.Read()
+		if shared.IsNoMoreDocuments(err) {
+			break
+		}
+		if err != nil {
+			return state, from, true, err
+		}
+
+		entries++
+		event, ok := c.newEvent(ctx, entry)
+		if ok {
+			if err := handler(ctx, event); err != nil {
+				return state, from, true, changeFeedHandlerError{err: err}
+			}
+		} else if c.checkpointCollection != "" && event.Collection == c.checkpointCollection {
+			checkpoints++
+		}
+
+		from = entry.Tick
+		c.setTick(from)
+	}
+
+	if state.LastIncluded != "" && state.LastIncluded != "0" {
+		from = state.LastIncluded
+		c.setTick(from)
+	}
+
+	return state, from, entries == 0 || entries > checkpoints, nil
+}
+
+// newEvent creates the event from the log entry.
+// It returns false when the event should not be passed to the handler.
+func (c *changeFeed) newEvent(ctx context.Context, entry ReplicationLogEntry) (ChangeFeedEvent, bool) {
+	event := ChangeFeedEvent{ReplicationLogEntry: entry}
+
+	var data struct {
+		Key  string `json:"_key,omitempty"`
+		Rev  string `json:"_rev,omitempty"`
+		Name string `json:"name,omitempty"`
+	}
+	if len(entry.Data) > 0 {
+		// The data of some operations is not an object, so the error is ignored.
+		_ = json.Unmarshal(entry.Data, &data)
+	}
+
+	switch entry.Type {
+	case ReplicationLogEntryTypeCollectionCreate, ReplicationLogEntryTypeCollectionRename:
+		if entry.CollectionGloballyUniqueID != "" && data.Name != "" {
+			c.collections[entry.CollectionGloballyUniqueID] = data.Name
+		}
+	case ReplicationLogEntryTypeDocumentInsertOrReplace, ReplicationLogEntryTypeDocumentRemove:
+		event.Key = data.Key
+		event.Rev = data.Rev
+	}
+
+	event.Collection = c.collectionName(ctx, entry)
+
+	if c.checkpointCollection != "" && event.Collection == c.checkpointCollection {
+		return event, false
+	}
+
+	if c.filter != nil && !c.filter[event.Collection] {
+		return event, false
+	}
+
+	return event, true
+}
+
+// collectionName returns the name of the collection of the log entry.
+func (c *changeFeed) collectionName(ctx context.Context, entry ReplicationLogEntry) string {
+	if entry.CollectionName != "" {
+		return entry.CollectionName
+	}
+
+	if entry.CollectionGloballyUniqueID == "" {
+		return ""
+	}
+
+	if name, ok := c.collections[entry.CollectionGloballyUniqueID]; ok {
+		return name
+	}
+
+	// The collection might have been created after the inventory has been loaded.
+	if err := c.loadCollections(ctx); err == nil {
+		if name, ok := c.collections[entry.CollectionGloballyUniqueID]; ok {
+			return name
+		}
+	}
+
+	// Do not try to load the inventory again for the same collection.
+	c.collections[entry.CollectionGloballyUniqueID] = ""
+
+	return ""
+}
+
+// loadCollections loads the names of the collections from the inventory of the database.
+func (c *changeFeed) loadCollections(ctx context.Context) error {
+	includeSystem := true
+	inventory, err := c.client.ReplicationInventory(ctx, c.dbName, &ReplicationInventoryOptions{
+		IncludeSystem: &includeSystem,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, col := range inventory.Collections {
+		if col.Parameters.GloballyUniqueId != "" {
+			c.collections[col.Parameters.GloballyUniqueId] = col.Parameters.Name
+		}
+	}
+
+	return nil
+}
+
+func (c *changeFeed) wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isChangeFeedRetryable returns true if the request can be repeated, e.g. the connection has been lost
+// or the server is temporarily not available.
+func isChangeFeedRetryable(err error) bool {
+	return connection.IsNetworkError(err) ||
+		shared.IsArangoErrorWithCode(err, http.StatusServiceUnavailable) ||
+		shared.IsArangoErrorWithCode(err, http.StatusBadGateway) ||
+		shared.IsArangoErrorWithCode(err, http.StatusGatewayTimeout)
+}
+
+func (o ChangeFeedOptions) pollInterval() time.Duration {
+	if o.PollInterval <= 0 {
+		return time.Second
+	}
+
+	return o.PollInterval
+}
+
+func (o ChangeFeedOptions) retryInterval() time.Duration {
+	if o.RetryInterval <= 0 {
+		return time.Second
+	}
+
+	return o.RetryInterval
+}
+
+// NewChangeFeedMemoryCheckpointStore returns the checkpoint store which keeps the tick only in the memory.
+func NewChangeFeedMemoryCheckpointStore() ChangeFeedCheckpointStore {
+	return &changeFeedMemoryCheckpointStore{}
+}
+
+type changeFeedMemoryCheckpointStore struct {
+	lock sync.Mutex
+	tick Tick
+}
+
+func (s *changeFeedMemoryCheckpointStore) Load(_ context.Context) (Tick, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.tick, nil
+}
+
+func (s *changeFeedMemoryCheckpointStore) Save(_ context.Context, tick Tick) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.tick = tick
+	return nil
+}
+
+// NewChangeFeedCollectionCheckpointStore returns the checkpoint store which saves the tick
+// in the document with the given key in the given collection.
+// When the collection belongs to the database of the change feed, its events are not passed to the handler.
+func NewChangeFeedCollectionCheckpointStore(col Collection, key string) ChangeFeedCheckpointStore {
+	return &changeFeedCollectionCheckpointStore{col: col, key: key}
+}
+
+type changeFeedCollectionCheckpointStore struct {
+	col Collection
+	key string
+}
+
+type changeFeedCheckpoint struct {
+	Key  string `json:"_key"`
+	Tick Tick   `json:"tick"`
+}
+
+func (s *changeFeedCollectionCheckpointStore) Load(ctx context.Context) (Tick, error) {
+	var checkpoint changeFeedCheckpoint
+
+	if _, err := s.col.ReadDocument(ctx, s.key, &checkpoint); err != nil {
+		if shared.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return checkpoint.Tick, nil
+}
+
+func (s *changeFeedCollectionCheckpointStore) Save(ctx context.Context, tick Tick) error {
+	overwrite := true
+	_, err := s.col.CreateDocumentWithOptions(ctx, changeFeedCheckpoint{Key: s.key, Tick: tick},
+		&CollectionDocumentCreateOptions{Overwrite: &overwrite})
+
+	return err
+}
@@ -0,0 +1,369 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// newChangeFeedTestServer returns the server which simulates the replication API of a single database.
+// The first request to the log fails like during a failover.
+func newChangeFeedTestServer(t *testing.T) (*httptest.Server, *int32) {
+	var tailRequests int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/_db/db/_api/replication/logger-state", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+		w.Write([]byte(`{"state":{"running":true,"lastLogTick":"100"},"server":{"version":"3.10.0","serverId":"1"}}`))
+	})
+	mux.HandleFunc("/_db/db/_api/replication/inventory", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+		w.Write([]byte(`{"collections":[{"parameters":{"name":"users","globallyUniqueId":"h1"}},` +
+			`{"parameters":{"name":"other","globallyUniqueId":"h2"}}]}`))
+	})
+	mux.HandleFunc("/_db/db/_api/wal/tail", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+
+		switch atomic.AddInt32(&tailRequests, 1) {
+		case 1:
+			writeTestError(w, http.StatusServiceUnavailable, shared.ErrClusterNotLeader)
+		case 2:
+			assert.Equal(t, "100", r.URL.Query().Get("from"))
+			w.Header().Set("x-arango-replication-checkmore", "true")
+			w.Header().Set("x-arango-replication-frompresent", "true")
+			w.Header().Set("x-arango-replication-lastincluded", "103")
+			w.Write([]byte(`{"tick":"101","type":2300,"db":"db","cuid":"h1","data":{"_key":"a","_rev":"r1"}}
+{"tick":"102","type":2300,"db":"db","cuid":"h2","data":{"_key":"b","_rev":"r2"}}
+{"tick":"103","type":2000,"db":"db","cuid":"h3","data":{"name":"new","globallyUniqueId":"h3"}}
+`))
+		default:
+			assert.Equal(t, "103", r.URL.Query().Get("from"))
+			w.Header().Set("x-arango-replication-frompresent", "true")
+			w.Header().Set("x-arango-replication-lastincluded", "105")
+			w.Write([]byte(`{"tick":"104","type":2302,"db":"db","cuid":"h1","data":{"_key":"a","_rev":"r3"}}
+{"tick":"105","type":2300,"db":"db","cuid":"h3","data":{"_key":"c","_rev":"r4"}}
+`))
+		}
+	})
+
+	return newTestServer(t, mux), &tailRequests
+}
+
+func TestChangeFeed_Run(t *testing.T) {
+	server, _ := newChangeFeedTestServer(t)
+
+	store := NewChangeFeedMemoryCheckpointStore()
+	retries := 0
+	feed := NewChangeFeed(newTestClient(server.URL), "db", &ChangeFeedOptions{
+		Collections:     []string{"users", "new"},
+		CheckpointStore: store,
+		RetryInterval:   time.Millisecond,
+		PollInterval:    time.Millisecond,
+		OnRetry: func(err error, attempt int) {
+			retries++
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stop := errors.New("stop")
+	var events []ChangeFeedEvent
+	err := feed.Run(ctx, func(ctx context.Context, event ChangeFeedEvent) error {
+		events = append(events, event)
+		if len(events) == 4 {
+			return stop
+		}
+		return nil
+	})
+	require.Equal(t, stop, err)
+	assert.Equal(t, 1, retries)
+
+	require.Len(t, events, 4)
+	assert.Equal(t, "users", events[0].Collection)
+	assert.Equal(t, "a", events[0].Key)
+	assert.Equal(t, "r1", events[0].Rev)
+	assert.True(t, events[0].IsDocumentOperation())
+	assert.Equal(t, ReplicationLogEntryTypeCollectionCreate, events[1].Type)
+	assert.Equal(t, "new", events[1].Collection)
+	assert.Equal(t, ReplicationLogEntryTypeDocumentRemove, events[2].Type)
+	assert.Equal(t, "new", events[3].Collection)
+
+	// The last event has not been handled, so the feed continues from the previous one.
+	assert.Equal(t, Tick("104"), feed.Tick())
+	tick, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, Tick("104"), tick)
+}
+
+func TestChangeFeed_Events(t *testing.T) {
+	server, _ := newChangeFeedTestServer(t)
+
+	feed := NewChangeFeed(newTestClient(server.URL), "db", &ChangeFeedOptions{
+		From:          "100",
+		RetryInterval: time.Millisecond,
+		PollInterval:  time.Millisecond,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, errs := feed.Events(ctx)
+
+	var keys []string
+	for event := range events {
+		if event.IsDocumentOperation() {
+			keys = append(keys, event.Key)
+		}
+		if len(keys) == 4 {
+			cancel()
+			break
+		}
+	}
+	assert.Equal(t, []string{"a", "b", "a", "c"}, keys)
+
+	err := <-errs
+	assert.Equal(t, context.Canceled, errors.Cause(err))
+}
+
+func TestChangeFeed_MaxRetries(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_db/db/_api/wal/tail" {
+			writeTestError(w, http.StatusServiceUnavailable, shared.ErrClusterNotLeader)
+			return
+		}
+		writeTestResponse(w, http.StatusOK, struct{}{})
+	}))
+
+	attempts := 0
+	feed := NewChangeFeed(newTestClient(server.URL), "db", &ChangeFeedOptions{
+		From:          "1",
+		MaxRetries:    3,
+		RetryInterval: time.Millisecond,
+		OnRetry: func(err error, attempt int) {
+			attempts = attempt
+		},
+	})
+
+	err := feed.Run(context.Background(), func(ctx context.Context, event ChangeFeedEvent) error {
+		return nil
+	})
+	require.True(t, shared.IsNoLeader(err))
+	assert.Equal(t, 3, attempts)
+}
+
+func TestChangeFeed_Gap(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_db/db/_api/wal/tail" {
+			w.Header().Set("x-arango-replication-frompresent", "false")
+			writeTestResponse(w, http.StatusNoContent, nil)
+			return
+		}
+		writeTestResponse(w, http.StatusOK, struct{}{})
+	}))
+
+	feed := NewChangeFeed(newTestClient(server.URL), "db", &ChangeFeedOptions{From: "5"})
+
+	err := feed.Run(context.Background(), func(ctx context.Context, event ChangeFeedEvent) error {
+		return nil
+	})
+
+	var gapErr ChangeFeedGapError
+	require.True(t, errors.As(err, &gapErr))
+	assert.Equal(t, Tick("5"), gapErr.From)
+}
+
+func TestChangeFeed_Failover(t *testing.T) {
+	var tailRequests, inventoryRequests int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/_db/db/_api/replication/inventory", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&inventoryRequests, 1)
+		writeTestResponse(w, http.StatusOK, map[string]interface{}{
+			"collections": []interface{}{
+				map[string]interface{}{"parameters": map[string]string{"name": "users", "globallyUniqueId": "h1"}},
+			},
+		})
+	})
+	mux.HandleFunc("/_db/db/_api/wal/tail", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+
+		switch atomic.AddInt32(&tailRequests, 1) {
+		case 1:
+			w.Header().Set("x-arango-replication-frompresent", "true")
+			w.Header().Set("x-arango-replication-checkmore", "true")
+			w.Header().Set("x-arango-replication-lastincluded", "12")
+			w.Header().Set("x-arango-replication-lastscanned", "20")
+			w.Write([]byte(`{"tick":"11","type":2300,"db":"db","cuid":"h1","data":{"_key":"a","_rev":"r1"}}
+{"tick":"12","type":2300,"db":"db","cuid":"h1","data":{"_key":"b","_rev":"r2"}}
+`))
+		case 2:
+			assert.Equal(t, "20", r.URL.Query().Get("lastScanned"))
+			// The server fails over, so it is no longer the leader.
+			writeTestError(w, http.StatusServiceUnavailable, shared.ErrClusterNotLeader)
+		default:
+			// The new server is asked for the checkpointed tick, which it does not have anymore.
+			assert.Equal(t, "12", r.URL.Query().Get("from"))
+			assert.Empty(t, r.URL.Query().Get("lastScanned"))
+			w.Header().Set("x-arango-replication-frompresent", "false")
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	server := newTestServer(t, mux)
+
+	store := NewChangeFeedMemoryCheckpointStore()
+	retries := 0
+	feed := NewChangeFeed(newTestClient(server.URL), "db", &ChangeFeedOptions{
+		From:            "10",
+		CheckpointStore: store,
+		RetryInterval:   time.Millisecond,
+		OnRetry: func(err error, attempt int) {
+			assert.True(t, shared.IsNoLeader(err))
+			retries++
+		},
+	})
+
+	var keys []string
+	err := feed.Run(context.Background(), func(ctx context.Context, event ChangeFeedEvent) error {
+		keys = append(keys, event.Key)
+		return nil
+	})
+
+	var gapErr ChangeFeedGapError
+	require.True(t, errors.As(err, &gapErr))
+	assert.Equal(t, Tick("12"), gapErr.From)
+	assert.Equal(t, []string{"a", "b"}, keys)
+	assert.Equal(t, 1, retries)
+	// The inventory is loaded again from the new server.
+	assert.Equal(t, int32(2), atomic.LoadInt32(&inventoryRequests))
+
+	tick, err := store.Load(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Tick("12"), tick)
+}
+
+func TestChangeFeed_InvalidResponse(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_db/db/_api/wal/tail" {
+			w.Header().Set("x-arango-replication-frompresent", "true")
+			w.Header().Set("Content-Type", connection.ApplicationJSON)
+			w.Write([]byte("{\"tick\":]}\n"))
+			return
+		}
+		writeTestResponse(w, http.StatusOK, struct{}{})
+	}))
+
+	retries := 0
+	feed := NewChangeFeed(newTestClient(server.URL), "db", &ChangeFeedOptions{
+		From:          "1",
+		MaxRetries:    1,
+		RetryInterval: time.Millisecond,
+		OnRetry: func(err error, attempt int) {
+			retries++
+		},
+	})
+
+	// The response which can not be decoded is not retried.
+	err := feed.Run(context.Background(), func(ctx context.Context, event ChangeFeedEvent) error {
+		return nil
+	})
+	require.Error(t, err)
+	assert.Equal(t, 0, retries)
+}
+
+func TestChangeFeed_CheckpointCollection(t *testing.T) {
+	var tailRequests, saves int32
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/_db/db/_api/replication/inventory", func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, http.StatusOK, map[string]interface{}{
+			"collections": []interface{}{
+				map[string]interface{}{"parameters": map[string]string{"name": "users", "globallyUniqueId": "h1"}},
+				map[string]interface{}{"parameters": map[string]string{"name": "checkpoints", "globallyUniqueId": "h2"}},
+			},
+		})
+	})
+	mux.HandleFunc("/_db/db/_api/document/checkpoints", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&saves, 1)
+		writeTestResponse(w, http.StatusCreated, map[string]string{"_key": "feed"})
+	})
+	mux.HandleFunc("/_db/db/_api/wal/tail", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+		w.Header().Set("x-arango-replication-frompresent", "true")
+
+		requests := atomic.AddInt32(&tailRequests, 1)
+		switch requests {
+		case 1:
+			w.Header().Set("x-arango-replication-lastincluded", "12")
+			w.Write([]byte(`{"tick":"11","type":2300,"db":"db","cuid":"h1","data":{"_key":"a","_rev":"r1"}}
+{"tick":"12","type":2300,"db":"db","cuid":"h2","data":{"_key":"feed","_rev":"r2","tick":"10"}}
+`))
+		case 2:
+			// The checkpoint which has been saved after the first response.
+			assert.Equal(t, "12", r.URL.Query().Get("from"))
+			w.Header().Set("x-arango-replication-lastincluded", "13")
+			w.Write([]byte(`{"tick":"13","type":2300,"db":"db","cuid":"h2","data":{"_key":"feed","_rev":"r3","tick":"12"}}
+`))
+		default:
+			assert.Equal(t, "13", r.URL.Query().Get("from"))
+			if requests > 3 {
+				cancel()
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	server := newTestServer(t, mux)
+
+	c := newTestClient(server.URL)
+	col := newCollection(newDatabase(c, "db"), "checkpoints")
+	feed := NewChangeFeed(c, "db", &ChangeFeedOptions{
+		From:            "10",
+		CheckpointStore: NewChangeFeedCollectionCheckpointStore(col, "feed"),
+		PollInterval:    time.Millisecond,
+	})
+
+	var collections []string
+	err := feed.Run(ctx, func(ctx context.Context, event ChangeFeedEvent) error {
+		collections = append(collections, event.Collection)
+		return nil
+	})
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	assert.Equal(t, []string{"users"}, collections)
+	// Only the first response contains other events than the checkpoints.
+	assert.Equal(t, int32(1), atomic.LoadInt32(&saves))
+	assert.Equal(t, Tick("13"), feed.Tick())
+}
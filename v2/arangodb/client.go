@@ -32,4 +32,6 @@ type Client interface {
 	ClientServerInfo
 	ClientAdmin
 	ClientAsyncJob
+	ClientUsers
+	ClientReplication
 }
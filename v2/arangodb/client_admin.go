@@ -28,6 +28,8 @@ type ClientAdmin interface {
 	ClientAdminLog
 	ClientAdminBackup
 	ClientAdminLicense
+	ClientAdminCluster
+	ClientAdminMetrics
 
 	// Health returns the cluster configuration & health.
 	// It works in cluster or active fail-over mode.
@@ -41,9 +43,61 @@ type ClientAdmin interface {
 	// This call needs a client that uses JWT authentication.
 	// This call needs ArangoDB 3.3 and up.
 	SetServerMode(ctx context.Context, mode ServerMode) error
+
+	// Statistics queries statistics from a specific server.
+	// Deprecated: Use Metrics instead.
+	Statistics(ctx context.Context) (ServerStatistics, error)
+
+	// Shutdown shuts down a specific server, optionally removing it from its cluster.
+	// When the soft shutdown of a coordinator is requested, ShutdownInfo can be used to check the progress.
+	Shutdown(ctx context.Context, opts *ShutdownOptions) error
+
+	// ShutdownInfo returns information about the progress of the soft shutdown of a coordinator.
+	// It is available since versions: v3.7.12, v3.8.1, v3.9.0.
+	ShutdownInfo(ctx context.Context) (ShutdownInfo, error)
 }
 
+// ClientAdminBackup provides access to the hot backup API.
+// https://www.arangodb.com/docs/stable/http/hot-backup.html
 type ClientAdminBackup interface {
+	// CreateBackup creates a new backup and returns its metadata.
+	CreateBackup(ctx context.Context, opts *BackupCreateOptions) (BackupResponse, error)
+
+	// DeleteBackup deletes the backup with given id.
+	DeleteBackup(ctx context.Context, id string) error
+
+	// RestoreBackup restores the backup with given id.
+	RestoreBackup(ctx context.Context, id string, opts *BackupRestoreOptions) (BackupRestoreResponse, error)
+
+	// ListBackups returns metadata about some/all backups available.
+	ListBackups(ctx context.Context, opts *BackupListOptions) (ListBackupsResponse, error)
+
+	// UploadBackup triggers an upload of the backup with given id to the remote repository using the given config.
+	// The returned monitor can be used to track the progress of the transfer job.
+	// This call needs the Enterprise Edition.
+	UploadBackup(ctx context.Context, id string, remoteRepository string, config interface{}) (BackupTransferMonitor, error)
+
+	// DownloadBackup triggers a download of the backup with given id from the remote repository using the given config.
+	// The returned monitor can be used to track the progress of the transfer job.
+	// This call needs the Enterprise Edition.
+	DownloadBackup(ctx context.Context, id string, remoteRepository string, config interface{}) (BackupTransferMonitor, error)
+}
+
+// BackupTransferMonitor tracks a single upload or download job of a backup.
+type BackupTransferMonitor interface {
+	// JobID returns the id of the transfer job.
+	JobID() string
+
+	// Progress returns the progress state of the transfer job.
+	Progress(ctx context.Context) (BackupTransferProgressReport, error)
+
+	// Abort aborts the transfer job if possible.
+	Abort(ctx context.Context) error
+
+	// Wait polls the progress of the transfer job until it reaches a terminal state on all DBServers.
+	// When the context is canceled before, the transfer job is aborted and the context error is returned.
+	// An error is returned if the transfer job failed or has been canceled on any DBServer.
+	Wait(ctx context.Context, opts *BackupTransferWaitOptions) (BackupTransferProgressReport, error)
 }
 
 type ClientAdminLog interface {
@@ -51,6 +105,24 @@ type ClientAdminLog interface {
 	GetLogLevels(ctx context.Context, opts *LogLevelsGetOptions) (LogLevels, error)
 	// SetLogLevels sets log levels for a given topics.
 	SetLogLevels(ctx context.Context, logLevels LogLevels, opts *LogLevelsSetOptions) error
+	// Logs returns the global log of the server in ArangoDB 3.8.0+ format.
+	Logs(ctx context.Context, opts *LogEntriesOptions) (ServerLogs, error)
+}
+
+// ClientAdminMetrics provides access to the metrics of the servers.
+// List of metrics: https://www.arangodb.com/docs/stable/http/administration-and-monitoring-metrics.html
+type ClientAdminMetrics interface {
+	// Metrics returns the metrics of the server in Prometheus format.
+	// ParseMetrics can be used to parse them.
+	Metrics(ctx context.Context) ([]byte, error)
+
+	// MetricsForSingleServer returns the metrics of the specific server in Prometheus format.
+	// This parameter 'serverID' is only meaningful on Coordinators.
+	MetricsForSingleServer(ctx context.Context, serverID ServerID) ([]byte, error)
+
+	// MetricFamilies returns the parsed metrics of the server, mapped by the names of the metric families.
+	// This parameter 'serverID' is only meaningful on Coordinators, and it can be empty.
+	MetricFamilies(ctx context.Context, serverID ServerID) (MetricFamilies, error)
 }
 
 type ClientAdminLicense interface {
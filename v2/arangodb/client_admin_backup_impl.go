@@ -0,0 +1,466 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// BackupCreateOptions provides options for CreateBackup
+type BackupCreateOptions struct {
+	// Label is appended to the backup id
+	Label string `json:"label,omitempty"`
+
+	// Timeout is the time to wait for the global write lock
+	Timeout time.Duration `json:"-"`
+
+	// Force aborts all running transactions in order to obtain the global write lock
+	Force bool `json:"force,omitempty"`
+
+	// AllowInconsistent creates a backup, even if the global write lock can not be obtained within the timeout.
+	// The backup is then flagged with BackupResponse.PotentiallyInconsistent.
+	// @deprecated - since 3.10.10 it exists only for backwards compatibility
+	AllowInconsistent bool `json:"allowInconsistent,omitempty"`
+}
+
+// BackupResponse contains information about a newly created backup
+type BackupResponse struct {
+	ID                      string    `json:"id,omitempty"`
+	PotentiallyInconsistent bool      `json:"potentiallyInconsistent,omitempty"`
+	NumberOfFiles           uint      `json:"nrFiles,omitempty"`
+	NumberOfDBServers       uint      `json:"nrDBServers,omitempty"`
+	SizeInBytes             uint64    `json:"sizeInBytes,omitempty"`
+	CreationTime            time.Time `json:"datetime,omitempty"`
+}
+
+// BackupRestoreOptions provides options for RestoreBackup
+type BackupRestoreOptions struct {
+	// IgnoreVersion skips the version check when doing a restore (expert only)
+	IgnoreVersion bool `json:"ignoreVersion,omitempty"`
+}
+
+// BackupRestoreResponse contains information about a restored backup
+type BackupRestoreResponse struct {
+	// Previous is the id of the backup created automatically before the restore.
+	Previous string `json:"previous,omitempty"`
+}
+
+// BackupListOptions provides options for ListBackups
+type BackupListOptions struct {
+	// Only receive metadata about a specific id
+	ID string `json:"id,omitempty"`
+}
+
+// ListBackupsResponse contains metadata of available backups
+type ListBackupsResponse struct {
+	Server  string                `json:"server,omitempty"`
+	Backups map[string]BackupMeta `json:"list,omitempty"`
+}
+
+// BackupMeta provides metadata of a backup
+type BackupMeta struct {
+	BackupResponse
+
+	Version               string             `json:"version,omitempty"`
+	Available             bool               `json:"available,omitempty"`
+	NumberOfPiecesPresent uint               `json:"nrPiecesPresent,omitempty"`
+	Keys                  []BackupMetaSha256 `json:"keys,omitempty"`
+}
+
+// BackupMetaSha256 backup sha details
+type BackupMetaSha256 struct {
+	SHA256 string `json:"sha256"`
+}
+
+// BackupTransferStatus represents all possible states a transfer job can be in
+type BackupTransferStatus string
+
+const (
+	BackupTransferAcknowledged BackupTransferStatus = "ACK"
+	BackupTransferStarted      BackupTransferStatus = "STARTED"
+	BackupTransferCompleted    BackupTransferStatus = "COMPLETED"
+	BackupTransferFailed       BackupTransferStatus = "FAILED"
+	BackupTransferCancelled    BackupTransferStatus = "CANCELLED"
+)
+
+// IsTerminal returns true if the transfer job will not change its state anymore.
+func (s BackupTransferStatus) IsTerminal() bool {
+	switch s {
+	case BackupTransferCompleted, BackupTransferFailed, BackupTransferCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// BackupTransferReport provides progress information of a backup transfer job for a single DBServer
+type BackupTransferReport struct {
+	Status       BackupTransferStatus `json:"Status,omitempty"`
+	Error        int                  `json:"Error,omitempty"`
+	ErrorMessage string               `json:"ErrorMessage,omitempty"`
+	Progress     struct {
+		Total     int    `json:"Total,omitempty"`
+		Done      int    `json:"Done,omitempty"`
+		Timestamp string `json:"Timestamp,omitempty"`
+	} `json:"Progress,omitempty"`
+}
+
+// BackupTransferProgressReport provides progress information for a backup transfer job
+type BackupTransferProgressReport struct {
+	BackupID  string                          `json:"BackupID,omitempty"`
+	Cancelled bool                            `json:"Cancelled,omitempty"`
+	Timestamp string                          `json:"Timestamp,omitempty"`
+	DBServers map[string]BackupTransferReport `json:"DBServers,omitempty"`
+}
+
+// IsTerminal returns true if the transfer job reached a terminal state on all DBServers.
+func (r BackupTransferProgressReport) IsTerminal() bool {
+	if len(r.DBServers) == 0 {
+		return false
+	}
+
+	for _, report := range r.DBServers {
+		if !report.Status.IsTerminal() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// BackupTransferWaitOptions provides options for BackupTransferMonitor.Wait
+type BackupTransferWaitOptions struct {
+	// PollInterval is the time between two progress requests. Defaults to 1 second.
+	PollInterval time.Duration
+
+	// OnProgress is called with every progress report received from the server.
+	OnProgress func(report BackupTransferProgressReport)
+}
+
+func (o *BackupTransferWaitOptions) pollInterval() time.Duration {
+	if o == nil || o.PollInterval <= 0 {
+		return time.Second
+	}
+
+	return o.PollInterval
+}
+
+// backupTransferAbortTimeout is the time given to abort a transfer job after the waiting context was canceled.
+const backupTransferAbortTimeout = 30 * time.Second
+
+func (c clientAdmin) CreateBackup(ctx context.Context, opts *BackupCreateOptions) (BackupResponse, error) {
+	url := connection.NewUrl("_admin", "backup", "create")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                BackupResponse `json:"result,omitempty"`
+	}
+
+	body := struct {
+		*BackupCreateOptions `json:",inline,omitempty"`
+		Timeout              float64 `json:"timeout,omitempty"`
+	}{
+		BackupCreateOptions: opts,
+	}
+
+	if opts != nil {
+		body.Timeout = opts.Timeout.Seconds()
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, url, &response, &body)
+	if err != nil {
+		return BackupResponse{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated:
+		return response.Result, nil
+	default:
+		return BackupResponse{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) DeleteBackup(ctx context.Context, id string) error {
+	url := connection.NewUrl("_admin", "backup", "delete")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	body := struct {
+		ID string `json:"id"`
+	}{
+		ID: id,
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, url, &response, &body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) RestoreBackup(ctx context.Context, id string, opts *BackupRestoreOptions) (BackupRestoreResponse, error) {
+	url := connection.NewUrl("_admin", "backup", "restore")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                BackupRestoreResponse `json:"result,omitempty"`
+	}
+
+	body := struct {
+		*BackupRestoreOptions `json:",inline,omitempty"`
+		ID                    string `json:"id"`
+	}{
+		BackupRestoreOptions: opts,
+		ID:                   id,
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, url, &response, &body)
+	if err != nil {
+		return BackupRestoreResponse{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Result, nil
+	default:
+		return BackupRestoreResponse{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) ListBackups(ctx context.Context, opts *BackupListOptions) (ListBackupsResponse, error) {
+	url := connection.NewUrl("_admin", "backup", "list")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                ListBackupsResponse `json:"result,omitempty"`
+	}
+
+	if opts == nil {
+		opts = &BackupListOptions{}
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, url, &response, opts)
+	if err != nil {
+		return ListBackupsResponse{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Result, nil
+	default:
+		return ListBackupsResponse{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) UploadBackup(ctx context.Context, id string, remoteRepository string, config interface{}) (BackupTransferMonitor, error) {
+	return c.startBackupTransfer(ctx, backupTransferUpload, id, remoteRepository, config)
+}
+
+func (c clientAdmin) DownloadBackup(ctx context.Context, id string, remoteRepository string, config interface{}) (BackupTransferMonitor, error) {
+	return c.startBackupTransfer(ctx, backupTransferDownload, id, remoteRepository, config)
+}
+
+func (c clientAdmin) startBackupTransfer(ctx context.Context, kind backupTransferKind, id string, remoteRepository string, config interface{}) (BackupTransferMonitor, error) {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                map[string]string `json:"result,omitempty"`
+	}
+
+	body := struct {
+		ID         string      `json:"id"`
+		RemoteRepo string      `json:"remoteRepository"`
+		Config     interface{} `json:"config,omitempty"`
+	}{
+		ID:         id,
+		RemoteRepo: remoteRepository,
+		Config:     config,
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, kind.url(), &response, &body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusAccepted:
+		return &backupTransferMonitor{
+			client: c.client,
+			kind:   kind,
+			jobID:  response.Result[kind.jobIDField()],
+		}, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+type backupTransferKind string
+
+const (
+	backupTransferUpload   backupTransferKind = "upload"
+	backupTransferDownload backupTransferKind = "download"
+)
+
+func (k backupTransferKind) url() string {
+	return connection.NewUrl("_admin", "backup", string(k))
+}
+
+// jobIDField returns the name of the field which identifies the transfer job (`uploadId` or `downloadId`).
+func (k backupTransferKind) jobIDField() string {
+	return string(k) + "Id"
+}
+
+var _ BackupTransferMonitor = &backupTransferMonitor{}
+
+type backupTransferMonitor struct {
+	client *client
+	kind   backupTransferKind
+	jobID  string
+}
+
+func (b *backupTransferMonitor) JobID() string {
+	return b.jobID
+}
+
+func (b *backupTransferMonitor) Progress(ctx context.Context) (BackupTransferProgressReport, error) {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                BackupTransferProgressReport `json:"result,omitempty"`
+	}
+
+	body := map[string]interface{}{
+		b.kind.jobIDField(): b.jobID,
+	}
+
+	resp, err := connection.CallPost(ctx, b.client.connection, b.kind.url(), &response, body)
+	if err != nil {
+		return BackupTransferProgressReport{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Result, nil
+	default:
+		return BackupTransferProgressReport{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (b *backupTransferMonitor) Abort(ctx context.Context) error {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	body := map[string]interface{}{
+		b.kind.jobIDField(): b.jobID,
+		"abort":             true,
+	}
+
+	resp, err := connection.CallPost(ctx, b.client.connection, b.kind.url(), &response, body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusAccepted:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (b *backupTransferMonitor) Wait(ctx context.Context, opts *BackupTransferWaitOptions) (BackupTransferProgressReport, error) {
+	ticker := time.NewTicker(opts.pollInterval())
+	defer ticker.Stop()
+
+	for {
+		report, err := b.Progress(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return report, b.abortOnCancel(ctx)
+			}
+			return report, err
+		}
+
+		if opts != nil && opts.OnProgress != nil {
+			opts.OnProgress(report)
+		}
+
+		if report.IsTerminal() {
+			return report, b.transferError(report)
+		}
+
+		select {
+		case <-ctx.Done():
+			return report, b.abortOnCancel(ctx)
+		case <-ticker.C:
+		}
+	}
+}
+
+// abortOnCancel aborts the transfer job after the waiting context was canceled and returns the context error.
+func (b *backupTransferMonitor) abortOnCancel(ctx context.Context) error {
+	abortCtx, cancel := context.WithTimeout(context.Background(), backupTransferAbortTimeout)
+	defer cancel()
+
+	if err := b.Abort(abortCtx); err != nil {
+		return errors.Wrapf(ctx.Err(), "unable to abort backup transfer job %s: %s", b.jobID, err)
+	}
+
+	return ctx.Err()
+}
+
+// transferError returns an error describing all DBServers on which the transfer job did not complete.
+func (b *backupTransferMonitor) transferError(report BackupTransferProgressReport) error {
+	var failures []string
+
+	for server, r := range report.DBServers {
+		if r.Status == BackupTransferCompleted {
+			continue
+		}
+
+		failures = append(failures, fmt.Sprintf("%s: %s (%d) %s", server, r.Status, r.Error, r.ErrorMessage))
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	sort.Strings(failures)
+
+	return errors.Errorf("backup %s job %s did not complete: %s", b.kind, b.jobID, strings.Join(failures, ", "))
+}
@@ -0,0 +1,111 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupTransferProgressReport_IsTerminal(t *testing.T) {
+	tests := map[string]struct {
+		statuses []BackupTransferStatus
+		want     bool
+	}{
+		"no servers": {
+			want: false,
+		},
+		"all completed": {
+			statuses: []BackupTransferStatus{BackupTransferCompleted, BackupTransferCompleted},
+			want:     true,
+		},
+		"one started": {
+			statuses: []BackupTransferStatus{BackupTransferCompleted, BackupTransferStarted},
+			want:     false,
+		},
+		"one acknowledged": {
+			statuses: []BackupTransferStatus{BackupTransferAcknowledged},
+			want:     false,
+		},
+		"failed and cancelled": {
+			statuses: []BackupTransferStatus{BackupTransferFailed, BackupTransferCancelled},
+			want:     true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			report := BackupTransferProgressReport{DBServers: map[string]BackupTransferReport{}}
+			for i, s := range test.statuses {
+				report.DBServers[string(rune('A'+i))] = BackupTransferReport{Status: s}
+			}
+
+			assert.Equal(t, test.want, report.IsTerminal())
+		})
+	}
+}
+
+func TestBackupTransferMonitor_TransferError(t *testing.T) {
+	m := &backupTransferMonitor{kind: backupTransferUpload, jobID: "42"}
+
+	assert.NoError(t, m.transferError(BackupTransferProgressReport{
+		DBServers: map[string]BackupTransferReport{
+			"PRMR-1": {Status: BackupTransferCompleted},
+		},
+	}))
+
+	err := m.transferError(BackupTransferProgressReport{
+		DBServers: map[string]BackupTransferReport{
+			"PRMR-1": {Status: BackupTransferCompleted},
+			"PRMR-2": {Status: BackupTransferFailed, Error: 7, ErrorMessage: "remote error"},
+		},
+	})
+	assert.EqualError(t, err, "backup upload job 42 did not complete: PRMR-2: FAILED (7) remote error")
+}
+
+func TestClientAdmin_CreateBackup(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/_admin/backup/create", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"label": "daily", "allowInconsistent": true, "timeout": float64(5)}, body)
+
+		writeTestResponse(w, http.StatusCreated, map[string]interface{}{
+			"result": map[string]interface{}{"id": "2023-06-01T10.00.00Z_daily", "potentiallyInconsistent": true},
+		})
+	}))
+
+	backup, err := newTestClient(server.URL).CreateBackup(context.Background(), &BackupCreateOptions{
+		Label:             "daily",
+		Timeout:           5 * time.Second,
+		AllowInconsistent: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2023-06-01T10.00.00Z_daily", backup.ID)
+	assert.True(t, backup.PotentiallyInconsistent)
+}
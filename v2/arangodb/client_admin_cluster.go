@@ -0,0 +1,210 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"time"
+)
+
+// ClientAdminCluster provides access to cluster wide specific operations.
+// To use this interface, an ArangoDB cluster is required.
+// https://www.arangodb.com/docs/stable/http/cluster.html
+type ClientAdminCluster interface {
+	// DatabaseInventory returns the inventory of the cluster containing all collections (with entire details)
+	// of a database.
+	DatabaseInventory(ctx context.Context, dbName string) (DatabaseInventory, error)
+
+	// MoveShard moves a single shard of the given collection from server `fromServer` to server `toServer`.
+	// It returns the ID of the agency job.
+	MoveShard(ctx context.Context, col Collection, shard ShardID, fromServer, toServer ServerID) (string, error)
+
+	// CleanOutServer triggers activities to clean out a DBServer.
+	// It returns the ID of the agency job.
+	CleanOutServer(ctx context.Context, serverID ServerID) (string, error)
+
+	// ResignServer triggers activities to let a DBServer resign for all shards.
+	// It returns the ID of the agency job.
+	ResignServer(ctx context.Context, serverID ServerID) (string, error)
+
+	// NumberOfServers returns the number of coordinators & DBServers in a clusters and the
+	// ID's of cleaned out servers.
+	NumberOfServers(ctx context.Context) (NumberOfServersResponse, error)
+
+	// IsCleanedOut checks if the DBServer with given ID has been cleaned out.
+	IsCleanedOut(ctx context.Context, serverID ServerID) (bool, error)
+
+	// RemoveServer is a low-level option to remove a server from a cluster.
+	// This function is suitable for servers of type coordinator or DBServer.
+	RemoveServer(ctx context.Context, serverID ServerID) error
+
+	// GetClusterRebalance returns the current balance of shards and leaders in the cluster.
+	// This call needs ArangoDB 3.10 and up.
+	GetClusterRebalance(ctx context.Context) (RebalanceResponse, error)
+
+	// ComputeClusterRebalance computes a set of move shard operations to improve balance.
+	// The moves are not executed, use ExecuteClusterRebalance for it.
+	// This call needs ArangoDB 3.10 and up.
+	ComputeClusterRebalance(ctx context.Context, opts *RebalanceRequestBody) (RebalancePlan, error)
+
+	// ExecuteClusterRebalance executes the given set of move shard operations.
+	// This call needs ArangoDB 3.10 and up.
+	ExecuteClusterRebalance(ctx context.Context, moves []RebalanceMove) error
+
+	// ComputeAndExecuteClusterRebalance computes a set of move shard operations to improve balance and executes them.
+	// This call needs ArangoDB 3.10 and up.
+	ComputeAndExecuteClusterRebalance(ctx context.Context, opts *RebalanceRequestBody) (RebalancePlan, error)
+
+	// SetClusterMaintenanceMode enables or disables the supervision maintenance mode of the cluster.
+	// While enabled, the supervision does not react to failed servers.
+	SetClusterMaintenanceMode(ctx context.Context, enabled bool) error
+
+	// GetDBServerMaintenance returns the maintenance status of the given DBServer.
+	// This call needs ArangoDB 3.8 and up.
+	GetDBServerMaintenance(ctx context.Context, dbServer ServerID) (DBServerMaintenanceStatus, error)
+
+	// SetDBServerMaintenance enables or disables the maintenance mode of the given DBServer.
+	// This call needs ArangoDB 3.8 and up.
+	SetDBServerMaintenance(ctx context.Context, dbServer ServerID, opts *DBServerMaintenanceOptions) error
+}
+
+// NumberOfServersResponse holds the data returned from the `_admin/cluster/numberOfServers` API.
+type NumberOfServersResponse struct {
+	NoCoordinators   int        `json:"numberOfCoordinators,omitempty"`
+	NoDBServers      int        `json:"numberOfDBServers,omitempty"`
+	CleanedServerIDs []ServerID `json:"cleanedServers,omitempty"`
+}
+
+// RebalanceRequestBody contains options for computing a rebalance plan.
+type RebalanceRequestBody struct {
+	// Version must be set to 1.
+	Version int `json:"version"`
+	// MaximumNumberOfMoves is the maximum number of moves to be computed. Defaults to 1000.
+	MaximumNumberOfMoves int `json:"maximumNumberOfMoves,omitempty"`
+	// LeaderChanges allows leader changes without moving data.
+	LeaderChanges *bool `json:"leaderChanges,omitempty"`
+	// MoveLeaders allows moving leaders.
+	MoveLeaders *bool `json:"moveLeaders,omitempty"`
+	// MoveFollowers allows moving followers.
+	MoveFollowers *bool `json:"moveFollowers,omitempty"`
+	// PiFactor is a weighting factor that should remain untouched.
+	PiFactor float64 `json:"piFactor,omitempty"`
+	// DatabasesExcluded is a list of database names to exclude from the analysis.
+	DatabasesExcluded []string `json:"databasesExcluded,omitempty"`
+	// ExcludeSystemCollections ignores system collections in the rebalance plan.
+	ExcludeSystemCollections *bool `json:"excludeSystemCollections,omitempty"`
+}
+
+// RebalanceResponse describes the current balance of the cluster.
+type RebalanceResponse struct {
+	Leader            LeaderImbalance `json:"leader"`
+	Shards            ShardImbalance  `json:"shards"`
+	PendingMoveShards int             `json:"pendingMoveShards"`
+	TodoMoveShards    int             `json:"todoMoveShards"`
+}
+
+// LeaderImbalance describes the imbalance of leader shards between DBServers.
+type LeaderImbalance struct {
+	// WeightUsed is the weight of leader shards per DBServer.
+	WeightUsed []float64 `json:"weightUsed"`
+	// TargetWeight is the ideal weight of leader shards per DBServer.
+	TargetWeight []float64 `json:"targetWeight"`
+	// NumberShards is the number of leader shards per DBServer.
+	NumberShards []int `json:"numberShards"`
+	// LeaderDupl is the measure of leader shard distribution.
+	LeaderDupl []int `json:"leaderDupl"`
+	// TotalWeight is the sum of all weights.
+	TotalWeight float64 `json:"totalWeight"`
+	// Imbalance is the measure of leader shard imbalance.
+	Imbalance float64 `json:"imbalance"`
+	// TotalShards is the sum of shard leaders.
+	TotalShards int `json:"totalShards"`
+}
+
+// ShardImbalance describes the imbalance of the data distribution between DBServers.
+type ShardImbalance struct {
+	// SizeUsed is the size of shards per DBServer.
+	SizeUsed []float64 `json:"sizeUsed"`
+	// TargetSize is the ideal size of shards per DBServer.
+	TargetSize []float64 `json:"targetSize"`
+	// NumberShards is the number of leader and follower shards per DBServer.
+	NumberShards []int `json:"numberShards"`
+	// TotalUsed is the sum of the sizes.
+	TotalUsed float64 `json:"totalUsed"`
+	// TotalShards is the sum of shards.
+	TotalShards int `json:"totalShards"`
+	// Imbalance is the measure of shard size imbalance.
+	Imbalance float64 `json:"imbalance"`
+}
+
+// RebalancePlan contains the computed move shard operations and the balance before and after executing them.
+type RebalancePlan struct {
+	ImbalanceBefore RebalanceImbalance `json:"imbalanceBefore"`
+	ImbalanceAfter  RebalanceImbalance `json:"imbalanceAfter"`
+	Moves           []RebalanceMove    `json:"moves"`
+}
+
+// RebalanceImbalance describes the balance of leaders and shards.
+type RebalanceImbalance struct {
+	Leader LeaderImbalance `json:"leader"`
+	Shards ShardImbalance  `json:"shards"`
+}
+
+// RebalanceMove describes a single move shard operation.
+type RebalanceMove struct {
+	// From is the server name from which to move.
+	From ServerID `json:"from"`
+	// To is the ID of the destination server.
+	To ServerID `json:"to"`
+	// Shard is the shard ID of the shard to be moved.
+	Shard ShardID `json:"shard"`
+	// Collection is the collection ID of the collection the shard belongs to.
+	Collection string `json:"collection"`
+	// IsLeader is true if the shard leader is moved.
+	IsLeader bool `json:"isLeader"`
+	// Weight is the weight of the move.
+	Weight float64 `json:"weight"`
+}
+
+// DBServerMaintenanceMode describes the maintenance mode of a DBServer.
+type DBServerMaintenanceMode string
+
+const (
+	DBServerMaintenanceModeNormal      DBServerMaintenanceMode = "normal"
+	DBServerMaintenanceModeMaintenance DBServerMaintenanceMode = "maintenance"
+)
+
+// DBServerMaintenanceOptions contains options for SetDBServerMaintenance.
+type DBServerMaintenanceOptions struct {
+	// Mode is the maintenance mode to set.
+	Mode DBServerMaintenanceMode `json:"mode"`
+	// Timeout is the time after which the maintenance mode is disabled automatically.
+	// Only applicable when Mode is DBServerMaintenanceModeMaintenance.
+	Timeout time.Duration `json:"-"`
+}
+
+// DBServerMaintenanceStatus describes the maintenance status of a DBServer.
+type DBServerMaintenanceStatus struct {
+	// Mode of the DBServer. An empty mode means that the DBServer is not in maintenance mode.
+	Mode DBServerMaintenanceMode `json:"Mode"`
+	// Until is the time until the maintenance mode lasts.
+	Until time.Time `json:"Until"`
+}
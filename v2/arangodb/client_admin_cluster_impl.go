@@ -0,0 +1,339 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func (c clientAdmin) DatabaseInventory(ctx context.Context, dbName string) (DatabaseInventory, error) {
+	url := connection.NewUrl("_db", dbName, "_api", "replication", "clusterInventory")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		DatabaseInventory     `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return DatabaseInventory{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.DatabaseInventory, nil
+	default:
+		return DatabaseInventory{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) MoveShard(ctx context.Context, col Collection, shard ShardID, fromServer, toServer ServerID) (string, error) {
+	body := struct {
+		Database   string   `json:"database"`
+		Collection string   `json:"collection"`
+		Shard      ShardID  `json:"shard"`
+		FromServer ServerID `json:"fromServer"`
+		ToServer   ServerID `json:"toServer"`
+	}{
+		Database:   col.Database().Name(),
+		Collection: col.Name(),
+		Shard:      shard,
+		FromServer: fromServer,
+		ToServer:   toServer,
+	}
+
+	return c.startClusterJob(ctx, "moveShard", &body)
+}
+
+func (c clientAdmin) CleanOutServer(ctx context.Context, serverID ServerID) (string, error) {
+	body := struct {
+		Server ServerID `json:"server"`
+	}{
+		Server: serverID,
+	}
+
+	return c.startClusterJob(ctx, "cleanOutServer", &body)
+}
+
+func (c clientAdmin) ResignServer(ctx context.Context, serverID ServerID) (string, error) {
+	body := struct {
+		Server ServerID `json:"server"`
+	}{
+		Server: serverID,
+	}
+
+	return c.startClusterJob(ctx, "resignLeadership", &body)
+}
+
+// startClusterJob triggers a cluster operation which is executed as an agency job and returns the ID of the job.
+func (c clientAdmin) startClusterJob(ctx context.Context, operation string, body interface{}) (string, error) {
+	url := connection.NewUrl("_admin", "cluster", operation)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		JobID                 string `json:"id,omitempty"`
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, url, &response, body)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusAccepted:
+		return response.JobID, nil
+	default:
+		return "", response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) NumberOfServers(ctx context.Context) (NumberOfServersResponse, error) {
+	url := connection.NewUrl("_admin", "cluster", "numberOfServers")
+
+	var response struct {
+		shared.ResponseStruct   `json:",inline"`
+		NumberOfServersResponse `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return NumberOfServersResponse{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.NumberOfServersResponse, nil
+	default:
+		return NumberOfServersResponse{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) IsCleanedOut(ctx context.Context, serverID ServerID) (bool, error) {
+	r, err := c.NumberOfServers(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, id := range r.CleanedServerIDs {
+		if id == serverID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (c clientAdmin) RemoveServer(ctx context.Context, serverID ServerID) error {
+	url := connection.NewUrl("_admin", "cluster", "removeServer")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, url, &response, serverID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusAccepted:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) GetClusterRebalance(ctx context.Context) (RebalanceResponse, error) {
+	url := connection.NewUrl("_admin", "cluster", "rebalance")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                RebalanceResponse `json:"result,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return RebalanceResponse{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Result, nil
+	default:
+		return RebalanceResponse{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) ComputeClusterRebalance(ctx context.Context, opts *RebalanceRequestBody) (RebalancePlan, error) {
+	return c.clusterRebalance(ctx, http.MethodPost, opts)
+}
+
+func (c clientAdmin) ComputeAndExecuteClusterRebalance(ctx context.Context, opts *RebalanceRequestBody) (RebalancePlan, error) {
+	return c.clusterRebalance(ctx, http.MethodPut, opts)
+}
+
+func (c clientAdmin) clusterRebalance(ctx context.Context, method string, opts *RebalanceRequestBody) (RebalancePlan, error) {
+	url := connection.NewUrl("_admin", "cluster", "rebalance")
+
+	body := RebalanceRequestBody{}
+	if opts != nil {
+		body = *opts
+	}
+	if body.Version == 0 {
+		body.Version = 1
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                RebalancePlan `json:"result,omitempty"`
+	}
+
+	resp, err := connection.Call(ctx, c.client.connection, method, url, &response, connection.WithBody(&body))
+	if err != nil {
+		return RebalancePlan{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusAccepted:
+		return response.Result, nil
+	default:
+		return RebalancePlan{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) ExecuteClusterRebalance(ctx context.Context, moves []RebalanceMove) error {
+	url := connection.NewUrl("_admin", "cluster", "rebalance", "execute")
+
+	body := struct {
+		Version int             `json:"version"`
+		Moves   []RebalanceMove `json:"moves"`
+	}{
+		Version: 1,
+		Moves:   moves,
+	}
+
+	if body.Moves == nil {
+		body.Moves = []RebalanceMove{}
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, url, &response, &body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusAccepted:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) SetClusterMaintenanceMode(ctx context.Context, enabled bool) error {
+	url := connection.NewUrl("_admin", "cluster", "maintenance")
+
+	mode := "off"
+	if enabled {
+		mode = "on"
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, c.client.connection, url, &response, mode)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) GetDBServerMaintenance(ctx context.Context, dbServer ServerID) (DBServerMaintenanceStatus, error) {
+	url := connection.NewUrl("_admin", "cluster", "maintenance", string(dbServer))
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                *DBServerMaintenanceStatus `json:"result,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return DBServerMaintenanceStatus{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		if response.Result == nil {
+			return DBServerMaintenanceStatus{}, nil
+		}
+		return *response.Result, nil
+	default:
+		return DBServerMaintenanceStatus{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) SetDBServerMaintenance(ctx context.Context, dbServer ServerID, opts *DBServerMaintenanceOptions) error {
+	url := connection.NewUrl("_admin", "cluster", "maintenance", string(dbServer))
+
+	if opts == nil {
+		opts = &DBServerMaintenanceOptions{Mode: DBServerMaintenanceModeMaintenance}
+	}
+
+	body := struct {
+		*DBServerMaintenanceOptions `json:",inline"`
+		Timeout                     int `json:"timeout,omitempty"`
+	}{
+		DBServerMaintenanceOptions: opts,
+		Timeout:                    int(opts.Timeout.Seconds()),
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, c.client.connection, url, &response, &body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
@@ -99,6 +99,145 @@ const (
 	ServerModeReadOnly ServerMode = "readonly"
 )
 
+// ServerStatistics contains statistical data about the server as a whole.
+type ServerStatistics struct {
+	Time       float64     `json:"time"`
+	Enabled    bool        `json:"enabled"`
+	System     SystemStats `json:"system"`
+	Client     ClientStats `json:"client"`
+	ClientUser ClientStats `json:"clientUser,omitempty"`
+	HTTP       HTTPStats   `json:"http"`
+	Server     ServerStats `json:"server"`
+}
+
+// SystemStats contains statistical data about the system, this is part of
+// ServerStatistics.
+type SystemStats struct {
+	MinorPageFaults     int64   `json:"minorPageFaults"`
+	MajorPageFaults     int64   `json:"majorPageFaults"`
+	UserTime            float64 `json:"userTime"`
+	SystemTime          float64 `json:"systemTime"`
+	NumberOfThreads     int64   `json:"numberOfThreads"`
+	ResidentSize        int64   `json:"residentSize"`
+	ResidentSizePercent float64 `json:"residentSizePercent"`
+	VirtualSize         int64   `json:"virtualSize"`
+}
+
+// Stats is used for various time-related statistics.
+type Stats struct {
+	Sum    float64 `json:"sum"`
+	Count  int64   `json:"count"`
+	Counts []int64 `json:"counts"`
+}
+
+// ClientStats contains statistics about the client connections.
+type ClientStats struct {
+	HTTPConnections int64 `json:"httpConnections"`
+	ConnectionTime  Stats `json:"connectionTime"`
+	TotalTime       Stats `json:"totalTime"`
+	RequestTime     Stats `json:"requestTime"`
+	QueueTime       Stats `json:"queueTime"`
+	IoTime          Stats `json:"ioTime"`
+	BytesSent       Stats `json:"bytesSent"`
+	BytesReceived   Stats `json:"bytesReceived"`
+}
+
+// HTTPStats contains statistics about the HTTP traffic.
+type HTTPStats struct {
+	RequestsTotal     int64 `json:"requestsTotal"`
+	RequestsAsync     int64 `json:"requestsAsync"`
+	RequestsGet       int64 `json:"requestsGet"`
+	RequestsHead      int64 `json:"requestsHead"`
+	RequestsPost      int64 `json:"requestsPost"`
+	RequestsPut       int64 `json:"requestsPut"`
+	RequestsPatch     int64 `json:"requestsPatch"`
+	RequestsDelete    int64 `json:"requestsDelete"`
+	RequestsOptions   int64 `json:"requestsOptions"`
+	RequestsOther     int64 `json:"requestsOther"`
+	RequestsSuperuser int64 `json:"requestsSuperuser,omitempty"`
+	RequestsUser      int64 `json:"requestsUser,omitempty"`
+}
+
+// TransactionStats contains statistics about transactions.
+type TransactionStats struct {
+	Started             int64 `json:"started"`
+	Aborted             int64 `json:"aborted"`
+	Committed           int64 `json:"committed"`
+	IntermediateCommits int64 `json:"intermediateCommits"`
+	ReadOnly            int64 `json:"readOnly,omitempty"`
+	DirtyReadOnly       int64 `json:"dirtyReadOnly,omitempty"`
+}
+
+// MemoryStats contains statistics about memory usage.
+type MemoryStats struct {
+	ContextID    int64   `json:"contextId"`
+	TMax         float64 `json:"tMax"`
+	CountOfTimes int64   `json:"countOfTimes"`
+	HeapMax      int64   `json:"heapMax"`
+	HeapMin      int64   `json:"heapMin"`
+	Invocations  int64   `json:"invocations,omitempty"`
+}
+
+// V8ContextStats contains statistics about V8 contexts.
+type V8ContextStats struct {
+	Available int64         `json:"available"`
+	Busy      int64         `json:"busy"`
+	Dirty     int64         `json:"dirty"`
+	Free      int64         `json:"free"`
+	Min       int64         `json:"min,omitempty"`
+	Max       int64         `json:"max"`
+	Memory    []MemoryStats `json:"memory"`
+}
+
+// ThreadStats contains statistics about threads.
+type ThreadStats struct {
+	SchedulerThreads int64 `json:"scheduler-threads"`
+	Blocked          int64 `json:"blocked"`
+	Queued           int64 `json:"queued"`
+	InProgress       int64 `json:"in-progress"`
+	DirectExec       int64 `json:"direct-exec"`
+}
+
+// ServerStats contains statistics about the server.
+type ServerStats struct {
+	Uptime         float64          `json:"uptime"`
+	PhysicalMemory int64            `json:"physicalMemory"`
+	Transactions   TransactionStats `json:"transactions"`
+	V8Context      V8ContextStats   `json:"v8Context"`
+	Threads        ThreadStats      `json:"threads"`
+}
+
+// ShutdownOptions describes the options of the server shutdown.
+type ShutdownOptions struct {
+	// RemoveFromCluster removes the server from its cluster.
+	RemoveFromCluster bool
+	// Soft runs the soft shutdown process of a coordinator, which waits for the ongoing operations to finish.
+	// It is available since versions: v3.7.12, v3.8.1, v3.9.0.
+	Soft bool
+}
+
+// ShutdownInfo describes the progress of the soft shutdown of a coordinator.
+type ShutdownInfo struct {
+	// AQLCursors stores a number of AQL cursors that are still active.
+	AQLCursors int `json:"AQLcursors"`
+	// Transactions stores a number of ongoing transactions.
+	Transactions int `json:"transactions"`
+	// PendingJobs stores a number of ongoing asynchronous requests.
+	PendingJobs int `json:"pendingJobs"`
+	// DoneJobs stores a number of finished asynchronous requests, whose result has not yet been collected.
+	DoneJobs int `json:"doneJobs"`
+	// PregelConductors stores a number of ongoing Pregel jobs.
+	PregelConductors int `json:"pregelConductors"`
+	// LowPrioOngoingRequests stores a number of ongoing low priority requests.
+	LowPrioOngoingRequests int `json:"lowPrioOngoingRequests"`
+	// LowPrioQueuedRequests stores a number of queued low priority requests.
+	LowPrioQueuedRequests int `json:"lowPrioQueuedRequests"`
+	// AllClear is set if all operations are closed.
+	AllClear bool `json:"allClear"`
+	// SoftShutdownOngoing describes whether a soft shutdown of the Coordinator is in progress.
+	SoftShutdownOngoing bool `json:"softShutdownOngoing"`
+}
+
 type clientAdmin struct {
 	client *client
 }
@@ -179,3 +318,75 @@ func (c clientAdmin) SetServerMode(ctx context.Context, mode ServerMode) error {
 		return response.AsArangoErrorWithCode(code)
 	}
 }
+
+func (c clientAdmin) Statistics(ctx context.Context) (ServerStatistics, error) {
+	url := connection.NewUrl("_admin", "statistics")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ServerStatistics      `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return ServerStatistics{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ServerStatistics, nil
+	default:
+		return ServerStatistics{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) Shutdown(ctx context.Context, opts *ShutdownOptions) error {
+	url := connection.NewUrl("_admin", "shutdown")
+
+	var mods []connection.RequestModifier
+	if opts != nil {
+		if opts.RemoveFromCluster {
+			mods = append(mods, connection.WithQuery("remove_from_cluster", "1"))
+		}
+		if opts.Soft {
+			mods = append(mods, connection.WithQuery("soft", "true"))
+		}
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, c.client.connection, url, &response, mods...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientAdmin) ShutdownInfo(ctx context.Context) (ShutdownInfo, error) {
+	url := connection.NewUrl("_admin", "shutdown")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ShutdownInfo          `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return ShutdownInfo{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ShutdownInfo, nil
+	default:
+		return ShutdownInfo{}, response.AsArangoErrorWithCode(code)
+	}
+}
@@ -23,6 +23,7 @@ package arangodb
 import (
 	"context"
 	"net/http"
+	"strconv"
 
 	"github.com/pkg/errors"
 
@@ -45,6 +46,39 @@ type LogLevelsSetOptions struct {
 	ServerID ServerID
 }
 
+// LogEntriesOptions describes the options of the log entries retrieval.
+type LogEntriesOptions struct {
+	// Upto returns the entries up to the given level (e.g. "fatal", "error", "warning", "info", "debug", "trace").
+	Upto string
+	// Level returns the entries of the given level only. It can not be used together with Upto.
+	Level string
+	// Start returns the entries whose ID is greater or equal to the given value.
+	Start *int
+	// Size restricts the result to at most the given number of entries.
+	Size *int
+	// Offset skips the given number of entries from the beginning of the result.
+	Offset *int
+	// Search returns the entries containing the given text only.
+	Search string
+	// Sort orders the entries by their IDs ("asc" or "desc").
+	Sort string
+	// ServerID returns the entries of the specific server. It is only meaningful on coordinators.
+	ServerID ServerID
+}
+
+type ServerLogs struct {
+	Total    int                `json:"total"`
+	Messages []ServerLogMessage `json:"messages,omitempty"`
+}
+
+type ServerLogMessage struct {
+	ID      int    `json:"id"`
+	Topic   string `json:"topic"`
+	Level   string `json:"level"`
+	Date    string `json:"date"`
+	Message string `json:"message"`
+}
+
 // GetLogLevels returns log levels for topics.
 func (c clientAdmin) GetLogLevels(ctx context.Context, opts *LogLevelsGetOptions) (LogLevels, error) {
 	url := connection.NewUrl("_admin", "log", "level")
@@ -98,3 +132,65 @@ func (c clientAdmin) SetLogLevels(ctx context.Context, logLevels LogLevels, opts
 		return response.AsArangoErrorWithCode(code)
 	}
 }
+
+// Logs returns the global log of the server in ArangoDB 3.8.0+ format.
+func (c clientAdmin) Logs(ctx context.Context, opts *LogEntriesOptions) (ServerLogs, error) {
+	url := connection.NewUrl("_admin", "log", "entries")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ServerLogs            `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response, opts.modifyRequest)
+	if err != nil {
+		return ServerLogs{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ServerLogs, nil
+	default:
+		return ServerLogs{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (o *LogEntriesOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+
+	if o.Upto != "" {
+		r.AddQuery("upto", o.Upto)
+	}
+
+	if o.Level != "" {
+		r.AddQuery("level", o.Level)
+	}
+
+	if o.Start != nil {
+		r.AddQuery("start", strconv.Itoa(*o.Start))
+	}
+
+	if o.Size != nil {
+		r.AddQuery("size", strconv.Itoa(*o.Size))
+	}
+
+	if o.Offset != nil {
+		r.AddQuery("offset", strconv.Itoa(*o.Offset))
+	}
+
+	if o.Search != "" {
+		r.AddQuery("search", o.Search)
+	}
+
+	if o.Sort != "" {
+		r.AddQuery("sort", o.Sort)
+	}
+
+	if o.ServerID != "" {
+		r.AddQuery("serverId", string(o.ServerID))
+	}
+
+	return nil
+}
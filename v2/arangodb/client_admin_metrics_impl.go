@@ -0,0 +1,469 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// MetricType is the type of the metric family.
+type MetricType string
+
+const (
+	MetricTypeCounter   MetricType = "counter"
+	MetricTypeGauge     MetricType = "gauge"
+	MetricTypeHistogram MetricType = "histogram"
+	MetricTypeSummary   MetricType = "summary"
+	MetricTypeUntyped   MetricType = "untyped"
+)
+
+// MetricFamilies maps the names of the metric families to the metric families.
+type MetricFamilies map[string]MetricFamily
+
+// MetricFamily is a group of metrics with the same name, e.g. the metrics of the same name with different labels.
+type MetricFamily struct {
+	Name string
+	Help string
+	Type MetricType
+
+	Metrics []Metric
+}
+
+// Metric is a single metric of the metric family, identified by its labels.
+type Metric struct {
+	Labels map[string]string
+	// Value is the value of the counter, gauge or untyped metric.
+	Value float64
+	// Histogram is set for the histogram metric.
+	Histogram *MetricHistogram
+	// Summary is set for the summary metric.
+	Summary *MetricSummary
+}
+
+type MetricHistogram struct {
+	// Buckets are sorted by their upper bounds. The counts are cumulative.
+	Buckets []MetricBucket
+	Sum     float64
+	Count   uint64
+}
+
+type MetricBucket struct {
+	UpperBound      float64
+	CumulativeCount uint64
+}
+
+type MetricSummary struct {
+	Quantiles []MetricQuantile
+	Sum       float64
+	Count     uint64
+}
+
+type MetricQuantile struct {
+	Quantile float64
+	Value    float64
+}
+
+// Value returns the value of the first metric in the family whose labels contain all the given labels.
+// It returns false if there is no such metric.
+func (f MetricFamilies) Value(name string, labels map[string]string) (float64, bool) {
+	family, ok := f[name]
+	if !ok {
+		return 0, false
+	}
+
+	metric, ok := family.FindMetric(labels)
+	if !ok {
+		return 0, false
+	}
+
+	return metric.Value, true
+}
+
+// FindMetric returns the first metric whose labels contain all the given labels.
+// It returns false if there is no such metric.
+func (f MetricFamily) FindMetric(labels map[string]string) (Metric, bool) {
+	for _, m := range f.Metrics {
+		if m.hasLabels(labels) {
+			return m, true
+		}
+	}
+
+	return Metric{}, false
+}
+
+func (m Metric) hasLabels(labels map[string]string) bool {
+	for k, v := range labels {
+		if m.Labels[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c clientAdmin) Metrics(ctx context.Context) ([]byte, error) {
+	return c.getMetrics(ctx, "")
+}
+
+func (c clientAdmin) MetricsForSingleServer(ctx context.Context, serverID ServerID) ([]byte, error) {
+	return c.getMetrics(ctx, serverID)
+}
+
+func (c clientAdmin) MetricFamilies(ctx context.Context, serverID ServerID) (MetricFamilies, error) {
+	data, err := c.getMetrics(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseMetrics(bytes.NewReader(data))
+}
+
+// getMetrics returns the metrics of the server in Prometheus format.
+func (c clientAdmin) getMetrics(ctx context.Context, serverID ServerID) ([]byte, error) {
+	url := connection.NewUrl("_admin", "metrics", "v2")
+
+	var mods []connection.RequestModifier
+	if serverID != "" {
+		mods = append(mods, connection.WithQuery("serverId", string(serverID)))
+	}
+
+	resp, body, err := connection.CallStream(ctx, c.client.connection, http.MethodGet, url, mods...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var data []byte
+	if body != nil {
+		defer body.Close()
+
+		if data, err = io.ReadAll(body); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return data, nil
+	default:
+		errData := byteDecoder{data: data}
+		return nil, errData.AsArangoErrorWithCode(code)
+	}
+}
+
+// ParseMetrics parses the metrics in the Prometheus text exposition format.
+// https://prometheus.io/docs/instrumenting/exposition_formats/#text-based-format
+func ParseMetrics(r io.Reader) (MetricFamilies, error) {
+	p := metricsParser{
+		families: map[string]*MetricFamily{},
+		indexes:  map[string]map[string]int{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		if err := p.parseLine(scanner.Text()); err != nil {
+			return nil, errors.Wrapf(err, "invalid metrics in line %d", lineNo)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := make(MetricFamilies, len(p.families))
+	for name, family := range p.families {
+		for _, m := range family.Metrics {
+			if m.Histogram != nil {
+				sort.Slice(m.Histogram.Buckets, func(i, j int) bool {
+					return m.Histogram.Buckets[i].UpperBound < m.Histogram.Buckets[j].UpperBound
+				})
+			}
+			if m.Summary != nil {
+				sort.Slice(m.Summary.Quantiles, func(i, j int) bool {
+					return m.Summary.Quantiles[i].Quantile < m.Summary.Quantiles[j].Quantile
+				})
+			}
+		}
+		result[name] = *family
+	}
+
+	return result, nil
+}
+
+type metricsParser struct {
+	families map[string]*MetricFamily
+	// indexes maps the names of the families to the indexes of their metrics by the label sets.
+	indexes map[string]map[string]int
+}
+
+func (p *metricsParser) family(name string) *MetricFamily {
+	f, ok := p.families[name]
+	if !ok {
+		f = &MetricFamily{Name: name, Type: MetricTypeUntyped}
+		p.families[name] = f
+		p.indexes[name] = map[string]int{}
+	}
+
+	return f
+}
+
+func (p *metricsParser) parseLine(line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(line, "#") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			// Regular comment.
+			return nil
+		}
+
+		switch fields[1] {
+		case "HELP":
+			help := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line[1:]), "HELP"))
+			help = strings.TrimSpace(strings.TrimPrefix(help, fields[2]))
+			p.family(fields[2]).Help = unescapeMetricHelp(help)
+		case "TYPE":
+			if len(fields) < 4 {
+				return errors.Errorf("missing type of %s", fields[2])
+			}
+			p.family(fields[2]).Type = MetricType(fields[3])
+		}
+
+		return nil
+	}
+
+	return p.parseSample(line)
+}
+
+func (p *metricsParser) parseSample(line string) error {
+	nameEnd := strings.IndexAny(line, "{ \t")
+	if nameEnd <= 0 {
+		return errors.Errorf("invalid sample '%s'", line)
+	}
+	name := line[:nameEnd]
+	rest := line[nameEnd:]
+
+	labels := map[string]string{}
+	if strings.HasPrefix(rest, "{") {
+		var err error
+		if labels, rest, err = parseMetricLabels(rest[1:]); err != nil {
+			return err
+		}
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return errors.Errorf("missing value of %s", name)
+	}
+
+	value, err := parseMetricValue(fields[0])
+	if err != nil {
+		return err
+	}
+
+	familyName, suffix := p.familyName(name)
+	family := p.family(familyName)
+
+	switch family.Type {
+	case MetricTypeHistogram:
+		metric := p.metric(family, labels, "le")
+		if metric.Histogram == nil {
+			metric.Histogram = &MetricHistogram{}
+		}
+		switch suffix {
+		case "_bucket":
+			bound, err := parseMetricValue(labels["le"])
+			if err != nil {
+				return err
+			}
+			metric.Histogram.Buckets = append(metric.Histogram.Buckets, MetricBucket{
+				UpperBound:      bound,
+				CumulativeCount: uint64(value),
+			})
+		case "_sum":
+			metric.Histogram.Sum = value
+		case "_count":
+			metric.Histogram.Count = uint64(value)
+		}
+	case MetricTypeSummary:
+		metric := p.metric(family, labels, "quantile")
+		if metric.Summary == nil {
+			metric.Summary = &MetricSummary{}
+		}
+		switch suffix {
+		case "_sum":
+			metric.Summary.Sum = value
+		case "_count":
+			metric.Summary.Count = uint64(value)
+		default:
+			quantile, err := parseMetricValue(labels["quantile"])
+			if err != nil {
+				return err
+			}
+			metric.Summary.Quantiles = append(metric.Summary.Quantiles, MetricQuantile{Quantile: quantile, Value: value})
+		}
+	default:
+		p.metric(family, labels, "").Value = value
+	}
+
+	return nil
+}
+
+// familyName returns the name of the family of the sample, and the suffix of the sample name
+// for the histograms and summaries.
+func (p *metricsParser) familyName(name string) (string, string) {
+	if _, ok := p.families[name]; ok {
+		return name, ""
+	}
+
+	for _, suffix := range []string{"_bucket", "_sum", "_count"} {
+		base := strings.TrimSuffix(name, suffix)
+		if base == name {
+			continue
+		}
+
+		if f, ok := p.families[base]; ok && (f.Type == MetricTypeHistogram || f.Type == MetricTypeSummary) {
+			return base, suffix
+		}
+	}
+
+	return name, ""
+}
+
+// metric returns the metric of the family with the given labels. The given special label is not taken into account.
+func (p *metricsParser) metric(family *MetricFamily, labels map[string]string, special string) *Metric {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		if k != special {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var id strings.Builder
+	metricLabels := make(map[string]string, len(keys))
+	for _, k := range keys {
+		id.WriteString(k)
+		id.WriteByte(0)
+		id.WriteString(labels[k])
+		id.WriteByte(0)
+		metricLabels[k] = labels[k]
+	}
+
+	index := p.indexes[family.Name]
+	if i, ok := index[id.String()]; ok {
+		return &family.Metrics[i]
+	}
+
+	family.Metrics = append(family.Metrics, Metric{Labels: metricLabels})
+	index[id.String()] = len(family.Metrics) - 1
+
+	return &family.Metrics[len(family.Metrics)-1]
+}
+
+// parseMetricLabels parses the labels which follow the opening brace.
+// It returns the labels and the rest of the line after the closing brace.
+func parseMetricLabels(s string) (map[string]string, string, error) {
+	labels := map[string]string{}
+
+	for {
+		s = strings.TrimLeft(s, " \t,")
+		if strings.HasPrefix(s, "}") {
+			return labels, s[1:], nil
+		}
+
+		eq := strings.IndexByte(s, '=')
+		if eq <= 0 || len(s) < eq+2 || s[eq+1] != '"' {
+			return nil, "", errors.Errorf("invalid labels '%s'", s)
+		}
+		name := strings.TrimSpace(s[:eq])
+		s = s[eq+2:]
+
+		var value strings.Builder
+		closed := false
+		for i := 0; i < len(s); i++ {
+			if s[i] == '\\' && i+1 < len(s) {
+				i++
+				switch s[i] {
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					value.WriteByte(s[i])
+				}
+				continue
+			}
+
+			if s[i] == '"' {
+				s = s[i+1:]
+				closed = true
+				break
+			}
+
+			value.WriteByte(s[i])
+		}
+
+		if !closed {
+			return nil, "", errors.Errorf("unterminated value of label %s", name)
+		}
+
+		labels[name] = value.String()
+	}
+}
+
+func parseMetricValue(s string) (float64, error) {
+	switch s {
+	case "+Inf":
+		return math.Inf(1), nil
+	case "-Inf":
+		return math.Inf(-1), nil
+	case "NaN":
+		return math.NaN(), nil
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid value '%s'", s)
+	}
+
+	return v, nil
+}
+
+func unescapeMetricHelp(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\n`, "\n").Replace(s)
+}
@@ -0,0 +1,135 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMetrics = `# HELP arangodb_scheduler_queue_length Server's internal queue length
+# TYPE arangodb_scheduler_queue_length gauge
+arangodb_scheduler_queue_length{role="SINGLE"} 3
+# HELP arangodb_http_requests_total Total number of HTTP requests
+# TYPE arangodb_http_requests_total counter
+arangodb_http_requests_total{method="GET",role="SINGLE"} 120
+arangodb_http_requests_total{method="POST",role="SINGLE"} 12
+# HELP arangodb_aql_query_time Execution time histogram for all AQL queries [s]
+# TYPE arangodb_aql_query_time histogram
+arangodb_aql_query_time_bucket{role="SINGLE",le="0.1"} 4
+arangodb_aql_query_time_bucket{role="SINGLE",le="+Inf"} 6
+arangodb_aql_query_time_bucket{role="SINGLE",le="0.01"} 2
+arangodb_aql_query_time_count{role="SINGLE"} 6
+arangodb_aql_query_time_sum{role="SINGLE"} 1.5
+# TYPE rpc_duration_seconds summary
+rpc_duration_seconds{quantile="0.99"} 76656
+rpc_duration_seconds{quantile="0.5"} 4773
+rpc_duration_seconds_sum 1.7560473e+07
+rpc_duration_seconds_count 2693
+# A regular comment
+untyped_metric{path="/a\"b\\c",} 1.5e3 1395066363000
+`
+
+func TestParseMetrics(t *testing.T) {
+	families, err := ParseMetrics(strings.NewReader(testMetrics))
+	require.NoError(t, err)
+	require.Len(t, families, 5)
+
+	t.Run("gauge", func(t *testing.T) {
+		f := families["arangodb_scheduler_queue_length"]
+		assert.Equal(t, MetricTypeGauge, f.Type)
+		assert.Equal(t, "Server's internal queue length", f.Help)
+
+		value, ok := families.Value("arangodb_scheduler_queue_length", nil)
+		require.True(t, ok)
+		assert.Equal(t, 3.0, value)
+	})
+
+	t.Run("counter", func(t *testing.T) {
+		f := families["arangodb_http_requests_total"]
+		assert.Equal(t, MetricTypeCounter, f.Type)
+		require.Len(t, f.Metrics, 2)
+
+		value, ok := families.Value("arangodb_http_requests_total", map[string]string{"method": "POST"})
+		require.True(t, ok)
+		assert.Equal(t, 12.0, value)
+
+		_, ok = families.Value("arangodb_http_requests_total", map[string]string{"method": "PUT"})
+		assert.False(t, ok)
+	})
+
+	t.Run("histogram", func(t *testing.T) {
+		f := families["arangodb_aql_query_time"]
+		assert.Equal(t, MetricTypeHistogram, f.Type)
+		require.Len(t, f.Metrics, 1)
+
+		m := f.Metrics[0]
+		assert.Equal(t, map[string]string{"role": "SINGLE"}, m.Labels)
+		require.NotNil(t, m.Histogram)
+		assert.Equal(t, uint64(6), m.Histogram.Count)
+		assert.Equal(t, 1.5, m.Histogram.Sum)
+		require.Len(t, m.Histogram.Buckets, 3)
+		assert.Equal(t, MetricBucket{UpperBound: 0.01, CumulativeCount: 2}, m.Histogram.Buckets[0])
+		assert.Equal(t, MetricBucket{UpperBound: 0.1, CumulativeCount: 4}, m.Histogram.Buckets[1])
+		assert.True(t, math.IsInf(m.Histogram.Buckets[2].UpperBound, 1))
+	})
+
+	t.Run("summary", func(t *testing.T) {
+		f := families["rpc_duration_seconds"]
+		assert.Equal(t, MetricTypeSummary, f.Type)
+		require.Len(t, f.Metrics, 1)
+
+		s := f.Metrics[0].Summary
+		require.NotNil(t, s)
+		assert.Equal(t, uint64(2693), s.Count)
+		assert.Equal(t, 1.7560473e+07, s.Sum)
+		assert.Equal(t, []MetricQuantile{{Quantile: 0.5, Value: 4773}, {Quantile: 0.99, Value: 76656}}, s.Quantiles)
+	})
+
+	t.Run("untyped", func(t *testing.T) {
+		f := families["untyped_metric"]
+		assert.Equal(t, MetricTypeUntyped, f.Type)
+		require.Len(t, f.Metrics, 1)
+		assert.Equal(t, `/a"b\c`, f.Metrics[0].Labels["path"])
+		assert.Equal(t, 1500.0, f.Metrics[0].Value)
+	})
+}
+
+func TestParseMetrics_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"missing value":        "metric_name\n",
+		"invalid value":        "metric_name abc\n",
+		"unterminated label":   `metric_name{a="b} 1` + "\n",
+		"invalid label":        `metric_name{a=b} 1` + "\n",
+		"invalid bucket bound": "# TYPE h histogram\nh_bucket{le=\"x\"} 1\n",
+	}
+
+	for testName, input := range tests {
+		t.Run(testName, func(t *testing.T) {
+			_, err := ParseMetrics(strings.NewReader(input))
+			require.Error(t, err)
+		})
+	}
+}
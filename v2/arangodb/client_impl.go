@@ -37,6 +37,8 @@ func newClient(connection connection.Connection) *client {
 	c.clientServerInfo = newClientServerInfo(c)
 	c.clientAdmin = newClientAdmin(c)
 	c.clientAsyncJob = newClientAsyncJob(c)
+	c.clientUsers = newClientUsers(c)
+	c.clientReplication = newClientReplication(c)
 
 	c.Requests = NewRequests(connection)
 
@@ -52,6 +54,8 @@ type client struct {
 	*clientServerInfo
 	*clientAdmin
 	*clientAsyncJob
+	*clientUsers
+	*clientReplication
 
 	Requests
 }
@@ -0,0 +1,239 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ClientReplication provides access to the replication API of a single server.
+// https://www.arangodb.com/docs/stable/http/replications-replication-dump.html
+type ClientReplication interface {
+	// CreateBatch creates a "batch" to prevent removal of state required for replication.
+	CreateBatch(ctx context.Context, dbName string, serverID int64, ttl time.Duration) (ReplicationBatch, error)
+
+	// ReplicationInventory returns the inventory of the server containing all collections (with entire details)
+	// of a database.
+	// When this function is called on a coordinator in a cluster, an ID of a DBServer must be provided in the options.
+	ReplicationInventory(ctx context.Context, dbName string, opts *ReplicationInventoryOptions) (DatabaseInventory, error)
+
+	// GetRevisionTree retrieves the Revision tree (Merkle tree) associated with the collection.
+	GetRevisionTree(ctx context.Context, dbName, batchID, collection string) (RevisionTree, error)
+
+	// GetRevisionsByRanges retrieves the revision IDs of documents within requested ranges.
+	// The response is streamed, so the reader must be closed when it is no longer needed.
+	GetRevisionsByRanges(ctx context.Context, dbName, batchID, collection string, minMaxRevision []RevisionMinMax,
+		resume RevisionUInt64) (RevisionRangesReader, error)
+
+	// GetRevisionDocuments retrieves documents by revision.
+	// The response is streamed, so the reader must be closed when it is no longer needed.
+	GetRevisionDocuments(ctx context.Context, dbName, batchID, collection string,
+		revisions Revisions) (RevisionDocumentsReader, error)
+
+	// LoggerState returns the current state of the server's replication logger.
+	LoggerState(ctx context.Context, dbName string) (ReplicationLoggerState, error)
+
+	// LoggerFollow returns the operations from the server's write-ahead log (WAL tailing).
+	// The response is streamed, so the reader must be closed when it is no longer needed.
+	// It is supported only by single servers and DB-Servers, coordinators do not provide the write-ahead log.
+	// https://www.arangodb.com/docs/stable/http/replications-wal-access.html
+	LoggerFollow(ctx context.Context, dbName string, opts *LoggerFollowOptions) (ReplicationLogReader, error)
+}
+
+// Tick is represent a place in either the Write-Ahead Log,
+// journals and datafiles value reported by the server
+type Tick string
+
+// ReplicationBatch represents state on the server used during
+// certain replication operations to keep state required
+// by the client (such as Write-Ahead Log, inventory and data-files)
+type ReplicationBatch interface {
+	// BatchID returns the id of this batch.
+	BatchID() string
+	// LastTick reported by the server for this batch
+	LastTick() Tick
+	// Extend the lifetime of an existing batch on the server
+	Extend(ctx context.Context, ttl time.Duration) error
+	// Delete deletes an existing batch on the server
+	Delete(ctx context.Context) error
+}
+
+type ReplicationInventoryOptions struct {
+	// IncludeSystem specifies whether system collections should be included.
+	IncludeSystem *bool
+	// Global specifies whether the inventory of all databases should be returned.
+	// It works only for the "_system" database.
+	Global *bool
+	// BatchID is the ID of the batch, which is used to take a snapshot of the inventory.
+	BatchID string
+	// Collection restricts the inventory to the given collection.
+	Collection string
+	// DBServerID is the ID of the DBServer which should return the inventory,
+	// when the request is sent to a coordinator.
+	DBServerID ServerID
+}
+
+// RevisionRangesReader reads the revisions of the requested ranges one by one.
+type RevisionRangesReader interface {
+	// Read returns the revisions of the next requested range.
+	// shared.NoMoreDocumentsError is returned when there are no more ranges in the response.
+	Read() (Revisions, error)
+
+	// Resume returns the revision from which the next request should be continued,
+	// when the server could not return all the revisions in this response.
+	// Zero is returned when all the revisions have been returned.
+	// It is known only after all the ranges have been read.
+	Resume() RevisionUInt64
+
+	// Close releases the response.
+	Close() error
+}
+
+// RevisionDocumentsReader reads the requested documents one by one.
+type RevisionDocumentsReader interface {
+	// Read unmarshalls the next document into the given object.
+	// shared.NoMoreDocumentsError is returned when there are no more documents in the response.
+	Read(document interface{}) error
+
+	// Close releases the response.
+	Close() error
+}
+
+type ReplicationLoggerState struct {
+	// State of the replication logger.
+	State State `json:"state"`
+	// Server contains the information about the server.
+	Server ReplicationLoggerServer `json:"server"`
+	// Clients contains the replication clients which have recently connected to the logger.
+	Clients []ReplicationLoggerClient `json:"clients,omitempty"`
+}
+
+type ReplicationLoggerServer struct {
+	Version  string `json:"version"`
+	ServerID string `json:"serverId"`
+	Engine   string `json:"engine,omitempty"`
+}
+
+type ReplicationLoggerClient struct {
+	SyncerID       string    `json:"syncerId,omitempty"`
+	ServerID       string    `json:"serverId,omitempty"`
+	ClientInfo     string    `json:"clientInfo,omitempty"`
+	Time           time.Time `json:"time"`
+	Expires        time.Time `json:"expires"`
+	LastServedTick Tick      `json:"lastServedTick"`
+}
+
+type LoggerFollowOptions struct {
+	// From is the exclusive lower bound tick value for results.
+	From Tick
+	// To is the inclusive upper bound tick value for results.
+	To Tick
+	// LastScanned should be set to the value of the last LastScanned value,
+	// returned by the previous call when CheckMore was true.
+	LastScanned Tick
+	// ChunkSize is the approximate maximum size of the returned result in bytes.
+	ChunkSize int
+	// Global specifies whether operations of all databases should be returned.
+	// It works only for the "_system" database.
+	Global *bool
+	// SyncerID is the ID of the client used to tail results.
+	SyncerID string
+	// ServerID is the ID of the client machine.
+	ServerID string
+	// ClientInfo is a short description of the client, used for informative purposes only.
+	ClientInfo string
+}
+
+// ReplicationLogState is the state of the write-ahead log returned in the headers of the LoggerFollow response.
+type ReplicationLogState struct {
+	// CheckMore is true when there are more operations available with ticks greater than LastIncluded.
+	CheckMore bool
+	// LastIncluded is the tick of the last operation included in the response.
+	// It should be used as the From value of the next request.
+	LastIncluded Tick
+	// LastScanned is the last tick the server scanned while computing the response.
+	LastScanned Tick
+	// LastTick is the last tick the logger server has logged.
+	LastTick Tick
+	// FromPresent is true when the requested From tick is still present in the log.
+	// If it is false, some operations may have been lost.
+	FromPresent bool
+	// Active is true when the replication logger is running.
+	Active bool
+}
+
+// ReplicationLogReader reads the operations from the write-ahead log one by one.
+type ReplicationLogReader interface {
+	// State returns the state of the log reported by the server.
+	State() ReplicationLogState
+
+	// Read reads the next operation from the log.
+	// shared.NoMoreDocumentsError is returned when there are no more operations in the response.
+	Read() (ReplicationLogEntry, error)
+
+	// Close releases the response.
+	Close() error
+}
+
+// ReplicationLogEntryType is the type of the operation in the write-ahead log.
+type ReplicationLogEntryType int
+
+const (
+	ReplicationLogEntryTypeDatabaseCreate          ReplicationLogEntryType = 1100
+	ReplicationLogEntryTypeDatabaseDrop            ReplicationLogEntryType = 1101
+	ReplicationLogEntryTypeCollectionCreate        ReplicationLogEntryType = 2000
+	ReplicationLogEntryTypeCollectionDrop          ReplicationLogEntryType = 2001
+	ReplicationLogEntryTypeCollectionRename        ReplicationLogEntryType = 2002
+	ReplicationLogEntryTypeCollectionChange        ReplicationLogEntryType = 2003
+	ReplicationLogEntryTypeCollectionTruncate      ReplicationLogEntryType = 2004
+	ReplicationLogEntryTypeIndexCreate             ReplicationLogEntryType = 2100
+	ReplicationLogEntryTypeIndexDrop               ReplicationLogEntryType = 2101
+	ReplicationLogEntryTypeViewCreate              ReplicationLogEntryType = 2110
+	ReplicationLogEntryTypeViewDrop                ReplicationLogEntryType = 2111
+	ReplicationLogEntryTypeViewChange              ReplicationLogEntryType = 2112
+	ReplicationLogEntryTypeTransactionStart        ReplicationLogEntryType = 2200
+	ReplicationLogEntryTypeTransactionCommit       ReplicationLogEntryType = 2201
+	ReplicationLogEntryTypeTransactionAbort        ReplicationLogEntryType = 2202
+	ReplicationLogEntryTypeDocumentInsertOrReplace ReplicationLogEntryType = 2300
+	ReplicationLogEntryTypeDocumentRemove          ReplicationLogEntryType = 2302
+)
+
+// ReplicationLogEntry is a single operation from the write-ahead log.
+type ReplicationLogEntry struct {
+	// Tick of the operation.
+	Tick Tick `json:"tick"`
+	// Type of the operation.
+	Type ReplicationLogEntryType `json:"type"`
+	// TransactionID is the ID of the transaction the operation belongs to ("0" for non-transactional operations).
+	TransactionID string `json:"tid,omitempty"`
+	// Database is the name of the database.
+	Database string `json:"db,omitempty"`
+	// CollectionGloballyUniqueID is the globally unique ID of the collection.
+	CollectionGloballyUniqueID string `json:"cuid,omitempty"`
+	// CollectionID is the ID of the collection.
+	CollectionID string `json:"cid,omitempty"`
+	// CollectionName is the name of the collection.
+	CollectionName string `json:"cname,omitempty"`
+	// Data contains the details of the operation, e.g. the document for the insert operation.
+	Data json.RawMessage `json:"data,omitempty"`
+}
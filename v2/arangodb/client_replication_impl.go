@@ -0,0 +1,370 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newClientReplication(client *client) *clientReplication {
+	return &clientReplication{
+		client: client,
+	}
+}
+
+var _ ClientReplication = &clientReplication{}
+
+type clientReplication struct {
+	client *client
+}
+
+// ErrBatchClosed occurs when there is an attempt closing or prolonging closed batch
+var ErrBatchClosed = errors.New("Batch already closed")
+
+func (c clientReplication) CreateBatch(ctx context.Context, dbName string, serverID int64, ttl time.Duration) (ReplicationBatch, error) {
+	url := c.url(dbName, "replication", "batch")
+
+	params := struct {
+		TTL float64 `json:"ttl"`
+	}{
+		TTL: ttl.Seconds(),
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		ID                    string `json:"id"`
+		LastTick              Tick   `json:"lastTick,omitempty"`
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, url, &response, params,
+		connection.WithQuery("serverId", strconv.FormatInt(serverID, 10)))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return &replicationBatch{
+			client:   c.client,
+			id:       response.ID,
+			lastTick: response.LastTick,
+			serverID: serverID,
+			database: dbName,
+		}, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientReplication) ReplicationInventory(ctx context.Context, dbName string, opts *ReplicationInventoryOptions) (DatabaseInventory, error) {
+	url := c.url(dbName, "replication", "inventory")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		DatabaseInventory     `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response, opts.modifyRequest)
+	if err != nil {
+		return DatabaseInventory{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.DatabaseInventory, nil
+	default:
+		return DatabaseInventory{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientReplication) GetRevisionTree(ctx context.Context, dbName, batchID, collection string) (RevisionTree, error) {
+	url := c.url(dbName, "replication", "revisions", "tree")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		RevisionTree          `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response,
+		connection.WithQuery("batchId", batchID), connection.WithQuery("collection", collection))
+	if err != nil {
+		return RevisionTree{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.RevisionTree, nil
+	default:
+		return RevisionTree{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientReplication) GetRevisionsByRanges(ctx context.Context, dbName, batchID, collection string,
+	minMaxRevision []RevisionMinMax, resume RevisionUInt64) (RevisionRangesReader, error) {
+	url := c.url(dbName, "replication", "revisions", "ranges")
+
+	modifiers := []connection.RequestModifier{
+		connection.WithQuery("batchId", batchID),
+		connection.WithQuery("collection", collection),
+		connection.WithBody(minMaxRevision),
+	}
+	if resume > 0 {
+		modifiers = append(modifiers, connection.WithQuery("resume", resume.String()))
+	}
+
+	_, body, err := c.stream(ctx, http.MethodPut, url, modifiers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRevisionRangesReader(body)
+}
+
+func (c clientReplication) GetRevisionDocuments(ctx context.Context, dbName, batchID, collection string,
+	revisions Revisions) (RevisionDocumentsReader, error) {
+	url := c.url(dbName, "replication", "revisions", "documents")
+
+	_, body, err := c.stream(ctx, http.MethodPut, url, connection.WithQuery("batchId", batchID),
+		connection.WithQuery("collection", collection), connection.WithBody(revisions))
+	if err != nil {
+		return nil, err
+	}
+
+	return newRevisionDocumentsReader(body)
+}
+
+func (c clientReplication) LoggerState(ctx context.Context, dbName string) (ReplicationLoggerState, error) {
+	url := c.url(dbName, "replication", "logger-state")
+
+	var response struct {
+		shared.ResponseStruct  `json:",inline"`
+		ReplicationLoggerState `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return ReplicationLoggerState{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.ReplicationLoggerState, nil
+	default:
+		return ReplicationLoggerState{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientReplication) LoggerFollow(ctx context.Context, dbName string, opts *LoggerFollowOptions) (ReplicationLogReader, error) {
+	url := c.url(dbName, "wal", "tail")
+
+	resp, body, err := c.stream(ctx, http.MethodGet, url, opts.modifyRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	state := ReplicationLogState{
+		CheckMore:    resp.Header("x-arango-replication-checkmore") == "true",
+		LastIncluded: Tick(resp.Header("x-arango-replication-lastincluded")),
+		LastScanned:  Tick(resp.Header("x-arango-replication-lastscanned")),
+		LastTick:     Tick(resp.Header("x-arango-replication-lasttick")),
+		FromPresent:  resp.Header("x-arango-replication-frompresent") == "true",
+		Active:       resp.Header("x-arango-replication-active") == "true",
+	}
+
+	return newReplicationLogReader(state, body), nil
+}
+
+// stream sends the request and returns the body of the successful response.
+// The response is always requested in JSON, so it can be decoded while it is being read.
+func (c clientReplication) stream(ctx context.Context, method, url string,
+	modifiers ...connection.RequestModifier) (connection.Response, io.ReadCloser, error) {
+	modifiers = append(modifiers, func(r connection.Request) error {
+		r.AddHeader("Accept", connection.ApplicationJSON)
+		return nil
+	})
+
+	resp, body, err := connection.CallStream(ctx, c.client.connection, method, url, modifiers...)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusNoContent:
+		return resp, body, nil
+	default:
+		var response shared.ResponseStruct
+		if body != nil {
+			defer body.Close()
+			// Try to parse the ArangoDB error response.
+			_ = json.NewDecoder(body).Decode(&response)
+		}
+		return nil, nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientReplication) url(dbName string, parts ...string) string {
+	return connection.NewUrl(append([]string{"_db", dbName, "_api"}, parts...)...)
+}
+
+func (o *ReplicationInventoryOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+
+	if o.IncludeSystem != nil {
+		r.AddQuery("includeSystem", boolToString(*o.IncludeSystem))
+	}
+
+	if o.Global != nil {
+		r.AddQuery("global", boolToString(*o.Global))
+	}
+
+	if o.BatchID != "" {
+		r.AddQuery("batchId", o.BatchID)
+	}
+
+	if o.Collection != "" {
+		r.AddQuery("collection", o.Collection)
+	}
+
+	if o.DBServerID != "" {
+		r.AddQuery("DBserver", string(o.DBServerID))
+	}
+
+	return nil
+}
+
+func (o *LoggerFollowOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+
+	if o.From != "" {
+		r.AddQuery("from", string(o.From))
+	}
+
+	if o.To != "" {
+		r.AddQuery("to", string(o.To))
+	}
+
+	if o.LastScanned != "" {
+		r.AddQuery("lastScanned", string(o.LastScanned))
+	}
+
+	if o.ChunkSize > 0 {
+		r.AddQuery("chunkSize", strconv.Itoa(o.ChunkSize))
+	}
+
+	if o.Global != nil {
+		r.AddQuery("global", boolToString(*o.Global))
+	}
+
+	if o.SyncerID != "" {
+		r.AddQuery("syncerId", o.SyncerID)
+	}
+
+	if o.ServerID != "" {
+		r.AddQuery("serverId", o.ServerID)
+	}
+
+	if o.ClientInfo != "" {
+		r.AddQuery("clientInfo", o.ClientInfo)
+	}
+
+	return nil
+}
+
+var _ ReplicationBatch = &replicationBatch{}
+
+type replicationBatch struct {
+	client   *client
+	id       string
+	lastTick Tick
+	serverID int64
+	database string
+	closed   int32
+}
+
+func (b *replicationBatch) BatchID() string {
+	return b.id
+}
+
+func (b *replicationBatch) LastTick() Tick {
+	return b.lastTick
+}
+
+func (b *replicationBatch) Extend(ctx context.Context, ttl time.Duration) error {
+	if atomic.LoadInt32(&b.closed) != 0 {
+		return errors.WithStack(ErrBatchClosed)
+	}
+
+	url := connection.NewUrl("_db", b.database, "_api", "replication", "batch", b.id)
+
+	input := struct {
+		TTL int64 `json:"ttl"`
+	}{
+		TTL: int64(ttl.Seconds()),
+	}
+
+	resp, err := connection.CallPut(ctx, b.client.connection, url, nil, input,
+		connection.WithQuery("serverId", strconv.FormatInt(b.serverID, 10)))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusNoContent:
+		return nil
+	default:
+		return shared.NewResponseStruct().AsArangoErrorWithCode(code)
+	}
+}
+
+func (b *replicationBatch) Delete(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&b.closed, 0, 1) {
+		return errors.WithStack(ErrBatchClosed)
+	}
+
+	url := connection.NewUrl("_db", b.database, "_api", "replication", "batch", b.id)
+
+	resp, err := connection.CallDelete(ctx, b.client.connection, url, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusNoContent:
+		return nil
+	default:
+		return shared.NewResponseStruct().AsArangoErrorWithCode(code)
+	}
+}
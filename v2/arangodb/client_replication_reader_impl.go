@@ -0,0 +1,230 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// emptyBody is used when the server does not return the body, e.g. for the "204 No Content" response.
+type emptyBody struct{}
+
+func (emptyBody) Read([]byte) (int, error) {
+	return 0, io.EOF
+}
+
+func (emptyBody) Close() error {
+	return nil
+}
+
+func bodyOrEmpty(body io.ReadCloser) io.ReadCloser {
+	if body == nil {
+		return emptyBody{}
+	}
+
+	return body
+}
+
+// expectDelim reads the next token and checks whether it is the expected delimiter.
+// The error message names the decoded data with what, e.g. "response".
+func expectDelim(decoder *json.Decoder, delim json.Delim, what string) error {
+	t, err := decoder.Token()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if d, ok := t.(json.Delim); !ok || d != delim {
+		return errors.Errorf("expected '%s' in the %s, got '%v'", delim, what, t)
+	}
+
+	return nil
+}
+
+func newRevisionRangesReader(body io.ReadCloser) (*revisionRangesReader, error) {
+	body = bodyOrEmpty(body)
+	decoder := json.NewDecoder(body)
+
+	if err := expectDelim(decoder, '{', "response"); err != nil {
+		body.Close()
+		return nil, err
+	}
+
+	return &revisionRangesReader{body: body, decoder: decoder}, nil
+}
+
+var _ RevisionRangesReader = &revisionRangesReader{}
+
+// revisionRangesReader decodes the object {"ranges": [[...], ...], "resume": "..."} while it is being read.
+type revisionRangesReader struct {
+	body    io.ReadCloser
+	decoder *json.Decoder
+
+	inRanges bool
+	done     bool
+	resume   RevisionUInt64
+}
+
+func (r *revisionRangesReader) Read() (Revisions, error) {
+	more, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if !more {
+		return nil, shared.NoMoreDocumentsError{}
+	}
+
+	var revisions Revisions
+	if err := r.decoder.Decode(&revisions); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return revisions, nil
+}
+
+func (r *revisionRangesReader) Resume() RevisionUInt64 {
+	return r.resume
+}
+
+func (r *revisionRangesReader) Close() error {
+	return r.body.Close()
+}
+
+// next moves the decoder to the next element of the "ranges" array.
+// It returns false when there are no more elements.
+func (r *revisionRangesReader) next() (bool, error) {
+	for !r.done {
+		if r.inRanges {
+			if r.decoder.More() {
+				return true, nil
+			}
+
+			if err := expectDelim(r.decoder, ']', "response"); err != nil {
+				return false, err
+			}
+			r.inRanges = false
+			continue
+		}
+
+		if !r.decoder.More() {
+			r.done = true
+			break
+		}
+
+		t, err := r.decoder.Token()
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+
+		switch t {
+		case "ranges":
+			t, err := r.decoder.Token()
+			if err != nil {
+				return false, errors.WithStack(err)
+			}
+			r.inRanges = t == json.Delim('[')
+		case "resume":
+			if err := r.decoder.Decode(&r.resume); err != nil {
+				return false, errors.WithStack(err)
+			}
+		default:
+			var skip json.RawMessage
+			if err := r.decoder.Decode(&skip); err != nil {
+				return false, errors.WithStack(err)
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func newRevisionDocumentsReader(body io.ReadCloser) (*revisionDocumentsReader, error) {
+	body = bodyOrEmpty(body)
+	decoder := json.NewDecoder(body)
+
+	if err := expectDelim(decoder, '[', "response"); err != nil {
+		body.Close()
+		return nil, err
+	}
+
+	return &revisionDocumentsReader{body: body, decoder: decoder}, nil
+}
+
+var _ RevisionDocumentsReader = &revisionDocumentsReader{}
+
+// revisionDocumentsReader decodes the array of documents while it is being read.
+type revisionDocumentsReader struct {
+	body    io.ReadCloser
+	decoder *json.Decoder
+}
+
+func (r *revisionDocumentsReader) Read(document interface{}) error {
+	if !r.decoder.More() {
+		return shared.NoMoreDocumentsError{}
+	}
+
+	return errors.WithStack(r.decoder.Decode(newUnmarshalInto(document)))
+}
+
+func (r *revisionDocumentsReader) Close() error {
+	return r.body.Close()
+}
+
+func newReplicationLogReader(state ReplicationLogState, body io.ReadCloser) *replicationLogReader {
+	body = bodyOrEmpty(body)
+
+	return &replicationLogReader{state: state, body: body, decoder: json.NewDecoder(body)}
+}
+
+var _ ReplicationLogReader = &replicationLogReader{}
+
+// replicationLogReader decodes the operations, which are sent by the server one per line.
+type replicationLogReader struct {
+	state   ReplicationLogState
+	body    io.ReadCloser
+	decoder *json.Decoder
+}
+
+func (r *replicationLogReader) State() ReplicationLogState {
+	return r.state
+}
+
+func (r *replicationLogReader) Read() (ReplicationLogEntry, error) {
+	var entry ReplicationLogEntry
+
+	if err := r.decoder.Decode(&entry); err != nil {
+		if err == io.EOF {
+			return ReplicationLogEntry{}, shared.NoMoreDocumentsError{}
+		}
+		return ReplicationLogEntry{}, errors.WithStack(err)
+	}
+
+	return entry, nil
+}
+
+func (r *replicationLogReader) Close() error {
+	return r.body.Close()
+}
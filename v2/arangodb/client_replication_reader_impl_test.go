@@ -0,0 +1,168 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func TestRevisionUInt64_JSON(t *testing.T) {
+	tests := map[string]RevisionUInt64{
+		`""`:            0,
+		`"_gfgBrKi---"`: decodeRevision([]byte("_gfgBrKi---")),
+		`"A"`:           2,
+	}
+
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			var rev RevisionUInt64
+			require.NoError(t, json.Unmarshal([]byte(input), &rev))
+			assert.Equal(t, expected, rev)
+
+			data, err := json.Marshal(rev)
+			require.NoError(t, err)
+			assert.Equal(t, input, string(data))
+		})
+	}
+
+	t.Run("null", func(t *testing.T) {
+		rev := RevisionUInt64(5)
+		require.NoError(t, json.Unmarshal([]byte(`null`), &rev))
+		assert.Equal(t, RevisionUInt64(0), rev)
+	})
+}
+
+func TestRevisionRangesReader(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		ranges []Revisions
+		resume RevisionUInt64
+	}{
+		"ranges and resume": {
+			body:   `{"ranges":[["A","B"],[],["C"]],"resume":"D"}`,
+			ranges: []Revisions{{2, 3}, {}, {4}},
+			resume: 5,
+		},
+		"resume first": {
+			body:   `{"resume":"D","ranges":[["A"]]}`,
+			ranges: []Revisions{{2}},
+			resume: 5,
+		},
+		"without resume": {
+			body:   `{"ranges":[["A"]],"error":false}`,
+			ranges: []Revisions{{2}},
+		},
+		"no ranges": {
+			body: `{"ranges":[]}`,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			reader, err := newRevisionRangesReader(io.NopCloser(strings.NewReader(test.body)))
+			require.NoError(t, err)
+			defer reader.Close()
+
+			var ranges []Revisions
+			for {
+				revisions, err := reader.Read()
+				if shared.IsNoMoreDocuments(err) {
+					break
+				}
+				require.NoError(t, err)
+				ranges = append(ranges, revisions)
+			}
+
+			assert.Len(t, ranges, len(test.ranges))
+			for i := range test.ranges {
+				assert.ElementsMatch(t, test.ranges[i], ranges[i])
+			}
+			assert.Equal(t, test.resume, reader.Resume())
+
+			_, err = reader.Read()
+			assert.True(t, shared.IsNoMoreDocuments(err))
+		})
+	}
+
+	t.Run("invalid response", func(t *testing.T) {
+		_, err := newRevisionRangesReader(io.NopCloser(strings.NewReader(`[]`)))
+		require.Error(t, err)
+	})
+}
+
+func TestRevisionDocumentsReader(t *testing.T) {
+	reader, err := newRevisionDocumentsReader(io.NopCloser(strings.NewReader(`[{"_key":"a"},{"_key":"b"}]`)))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	var keys []string
+	for {
+		var doc struct {
+			Key string `json:"_key"`
+		}
+		err := reader.Read(&doc)
+		if shared.IsNoMoreDocuments(err) {
+			break
+		}
+		require.NoError(t, err)
+		keys = append(keys, doc.Key)
+	}
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
+
+func TestReplicationLogReader(t *testing.T) {
+	body := `{"tick":"10","type":2300,"tid":"0","db":"_system","cuid":"h1","data":{"_key":"a"}}
+{"tick":"11","type":2302,"tid":"0","db":"_system","cuid":"h1","data":{"_key":"a"}}
+`
+	state := ReplicationLogState{CheckMore: true, LastIncluded: "11"}
+	reader := newReplicationLogReader(state, io.NopCloser(strings.NewReader(body)))
+	defer reader.Close()
+
+	assert.Equal(t, state, reader.State())
+
+	entry, err := reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, Tick("10"), entry.Tick)
+	assert.Equal(t, ReplicationLogEntryTypeDocumentInsertOrReplace, entry.Type)
+	assert.JSONEq(t, `{"_key":"a"}`, string(entry.Data))
+
+	entry, err = reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, ReplicationLogEntryTypeDocumentRemove, entry.Type)
+
+	_, err = reader.Read()
+	assert.True(t, shared.IsNoMoreDocuments(err))
+
+	t.Run("no content", func(t *testing.T) {
+		reader := newReplicationLogReader(ReplicationLogState{}, nil)
+		_, err := reader.Read()
+		assert.True(t, shared.IsNoMoreDocuments(err))
+	})
+}
@@ -0,0 +1,191 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newClientUsers(client *client) *clientUsers {
+	return &clientUsers{
+		client: client,
+	}
+}
+
+var _ ClientUsers = &clientUsers{}
+
+type clientUsers struct {
+	client *client
+}
+
+func (c clientUsers) User(ctx context.Context, name string) (User, error) {
+	url := c.url(name)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		userData              `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return newUser(c.client, response.userData), nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientUsers) UserExists(ctx context.Context, name string) (bool, error) {
+	_, err := c.User(ctx, name)
+	if err == nil {
+		return true, nil
+	}
+
+	if shared.IsNotFound(err) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func (c clientUsers) Users(ctx context.Context) ([]User, error) {
+	url := c.url()
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                []userData `json:"result,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		users := make([]User, len(response.Result))
+
+		for i, data := range response.Result {
+			users[i] = newUser(c.client, data)
+		}
+
+		return users, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientUsers) CreateUser(ctx context.Context, name string, options *UserOptions) (User, error) {
+	url := c.url()
+
+	input := struct {
+		*UserOptions `json:",inline,omitempty"`
+		Name         string `json:"user"`
+	}{
+		UserOptions: options,
+		Name:        name,
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		userData              `json:",inline"`
+	}
+
+	resp, err := connection.CallPost(ctx, c.client.connection, url, &response, &input)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated:
+		return newUser(c.client, response.userData), nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientUsers) ReplaceUser(ctx context.Context, name string, options *UserOptions) (User, error) {
+	return c.modifyUser(ctx, http.MethodPut, name, options)
+}
+
+func (c clientUsers) UpdateUser(ctx context.Context, name string, options *UserOptions) (User, error) {
+	return c.modifyUser(ctx, http.MethodPatch, name, options)
+}
+
+func (c clientUsers) RemoveUser(ctx context.Context, name string) error {
+	url := c.url(name)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, c.client.connection, url, &response)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusAccepted:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (c clientUsers) modifyUser(ctx context.Context, method, name string, options *UserOptions) (User, error) {
+	url := c.url(name)
+
+	if options == nil {
+		options = &UserOptions{}
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		userData              `json:",inline"`
+	}
+
+	resp, err := connection.Call(ctx, c.client.connection, method, url, &response, connection.WithBody(options))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return newUser(c.client, response.userData), nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+// url returns the path to the user (`_db/_system/_api/user/<name>/<parts>`)
+func (c clientUsers) url(parts ...string) string {
+	return connection.NewUrl(append([]string{"_db", "_system", "_api", "user"}, parts...)...)
+}
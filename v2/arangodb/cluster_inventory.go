@@ -0,0 +1,233 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+// DatabaseInventory describes a detailed state of the collections & shards of a specific database within a cluster.
+type DatabaseInventory struct {
+	// Details of database, this is present since ArangoDB 3.6
+	Info DatabaseInfo `json:"properties,omitempty"`
+	// Details of all collections
+	Collections []InventoryCollection `json:"collections,omitempty"`
+	// Details of all views
+	Views []InventoryView `json:"views,omitempty"`
+	State State           `json:"state,omitempty"`
+	Tick  string          `json:"tick,omitempty"`
+}
+
+// State describes the replication logger state of a server.
+type State struct {
+	Running                bool      `json:"running,omitempty"`
+	LastLogTick            string    `json:"lastLogTick,omitempty"`
+	LastUncommittedLogTick string    `json:"lastUncommittedLogTick,omitempty"`
+	TotalEvents            int64     `json:"totalEvents,omitempty"`
+	Time                   time.Time `json:"time,omitempty"`
+}
+
+// UnmarshalJSON unmarshals State from arangodb json representation.
+// Coordinators return the string "unused" instead of an object, which results in an empty State.
+func (s *State) UnmarshalJSON(d []byte) error {
+	var internal interface{}
+
+	if err := json.Unmarshal(d, &internal); err != nil {
+		return err
+	}
+
+	if _, ok := internal.(string); ok {
+		*s = State{}
+		return nil
+	}
+
+	type Alias State
+	out := Alias{}
+
+	if err := json.Unmarshal(d, &out); err != nil {
+		return &json.UnmarshalTypeError{
+			Value: string(d),
+			Type:  reflect.TypeOf(s).Elem(),
+		}
+	}
+
+	*s = State(out)
+
+	return nil
+}
+
+// IsReady returns true if the IsReady flag of all collections is set.
+func (i DatabaseInventory) IsReady() bool {
+	for _, c := range i.Collections {
+		if !c.IsReady {
+			return false
+		}
+	}
+	return true
+}
+
+// PlanVersion returns the plan version of the first collection in the given inventory.
+func (i DatabaseInventory) PlanVersion() int64 {
+	if len(i.Collections) == 0 {
+		return 0
+	}
+	return i.Collections[0].PlanVersion
+}
+
+// CollectionByName returns the InventoryCollection with given name.
+// Return false if not found.
+func (i DatabaseInventory) CollectionByName(name string) (InventoryCollection, bool) {
+	for _, c := range i.Collections {
+		if c.Parameters.Name == name {
+			return c, true
+		}
+	}
+	return InventoryCollection{}, false
+}
+
+// ViewByName returns the InventoryView with given name.
+// Return false if not found.
+func (i DatabaseInventory) ViewByName(name string) (InventoryView, bool) {
+	for _, v := range i.Views {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return InventoryView{}, false
+}
+
+// InventoryCollection is a single element of a DatabaseInventory, containing all information
+// of a specific collection.
+type InventoryCollection struct {
+	Parameters  InventoryCollectionParameters `json:"parameters"`
+	Indexes     []InventoryIndex              `json:"indexes,omitempty"`
+	PlanVersion int64                         `json:"planVersion,omitempty"`
+	IsReady     bool                          `json:"isReady,omitempty"`
+	AllInSync   bool                          `json:"allInSync,omitempty"`
+}
+
+// IndexByFieldsAndType returns the InventoryIndex with given fields & type.
+// Return false if not found.
+func (i InventoryCollection) IndexByFieldsAndType(fields []string, indexType string) (InventoryIndex, bool) {
+	for _, idx := range i.Indexes {
+		if idx.Type == indexType && idx.FieldsEqual(fields) {
+			return idx, true
+		}
+	}
+	return InventoryIndex{}, false
+}
+
+// InventoryCollectionParameters contains all configuration parameters of a collection in a database inventory.
+type InventoryCollectionParameters struct {
+	CacheEnabled          bool                     `json:"cacheEnabled,omitempty"`
+	Deleted               bool                     `json:"deleted,omitempty"`
+	DistributeShardsLike  string                   `json:"distributeShardsLike,omitempty"`
+	GloballyUniqueId      string                   `json:"globallyUniqueId,omitempty"`
+	ID                    string                   `json:"id,omitempty"`
+	Indexes               []InventoryIndex         `json:"indexes,omitempty"`
+	InternalValidatorType int                      `json:"internalValidatorType,omitempty"`
+	IsDisjoint            bool                     `json:"isDisjoint,omitempty"`
+	IsSmart               bool                     `json:"isSmart,omitempty"`
+	IsSmartChild          bool                     `json:"isSmartChild,omitempty"`
+	IsSystem              bool                     `json:"isSystem,omitempty"`
+	KeyOptions            CollectionKeyOptions     `json:"keyOptions"`
+	Name                  string                   `json:"name,omitempty"`
+	NumberOfShards        int                      `json:"numberOfShards,omitempty"`
+	PlanID                string                   `json:"planId,omitempty"`
+	ReplicationFactor     ReplicationFactor        `json:"replicationFactor,omitempty"`
+	Schema                *CollectionSchemaOptions `json:"schema,omitempty"`
+	ShadowCollections     []int                    `json:"shadowCollections,omitempty"`
+	ShardingStrategy      ShardingStrategy         `json:"shardingStrategy,omitempty"`
+	ShardKeys             []string                 `json:"shardKeys,omitempty"`
+	Shards                map[ShardID][]ServerID   `json:"shards,omitempty"`
+	// Optional only for some collections.
+	SmartGraphAttribute string `json:"smartGraphAttribute,omitempty"`
+	// Optional only for some collections.
+	SmartJoinAttribute         string           `json:"smartJoinAttribute,omitempty"`
+	Status                     CollectionStatus `json:"status,omitempty"`
+	SyncByRevision             bool             `json:"syncByRevision,omitempty"`
+	Type                       CollectionType   `json:"type,omitempty"`
+	UsesRevisionsAsDocumentIds bool             `json:"usesRevisionsAsDocumentIds,omitempty"`
+	WaitForSync                bool             `json:"waitForSync,omitempty"`
+	WriteConcern               int              `json:"writeConcern,omitempty"`
+	ComputedValues             []ComputedValue  `json:"computedValues,omitempty"`
+}
+
+// IsSatellite returns true if the collection is a satellite collection
+func (icp *InventoryCollectionParameters) IsSatellite() bool {
+	return icp.ReplicationFactor == ReplicationFactorSatellite
+}
+
+// InventoryIndex contains all configuration parameters of a single index of a collection in a database inventory.
+type InventoryIndex struct {
+	ID              string   `json:"id,omitempty"`
+	Type            string   `json:"type,omitempty"`
+	Fields          []string `json:"fields,omitempty"`
+	Unique          bool     `json:"unique"`
+	Sparse          bool     `json:"sparse"`
+	Deduplicate     bool     `json:"deduplicate"`
+	MinLength       int      `json:"minLength,omitempty"`
+	GeoJSON         bool     `json:"geoJson,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	ExpireAfter     int      `json:"expireAfter,omitempty"`
+	Estimates       bool     `json:"estimates,omitempty"`
+	FieldValueTypes string   `json:"fieldValueTypes,omitempty"`
+	CacheEnabled    *bool    `json:"cacheEnabled,omitempty"`
+}
+
+// FieldsEqual returns true when the given fields list equals the Fields list in the InventoryIndex.
+// The order of fields is irrelevant.
+func (i InventoryIndex) FieldsEqual(fields []string) bool {
+	return stringSliceEqualsIgnoreOrder(i.Fields, fields)
+}
+
+// InventoryView is a single element of a DatabaseInventory, containing all information
+// of a specific view.
+type InventoryView struct {
+	Name     string   `json:"name,omitempty"`
+	Deleted  bool     `json:"deleted,omitempty"`
+	ID       string   `json:"id,omitempty"`
+	IsSystem bool     `json:"isSystem,omitempty"`
+	PlanID   string   `json:"planId,omitempty"`
+	Type     ViewType `json:"type,omitempty"`
+	// Include all properties from an arangosearch view.
+	ArangoSearchViewProperties
+}
+
+// stringSliceEqualsIgnoreOrder returns true when the given lists contain the same elements.
+// The order of elements is irrelevant.
+func stringSliceEqualsIgnoreOrder(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	bMap := make(map[string]struct{})
+	for _, x := range b {
+		bMap[x] = struct{}{}
+	}
+	for _, x := range a {
+		if _, found := bMap[x]; !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_UnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		data    string
+		want    State
+		wantErr bool
+	}{
+		"unused": {
+			data: `"unused"`,
+			want: State{},
+		},
+		"object": {
+			data: `{"running":true,"lastLogTick":"123","totalEvents":5}`,
+			want: State{Running: true, LastLogTick: "123", TotalEvents: 5},
+		},
+		"invalid": {
+			data:    `[1]`,
+			wantErr: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			var s State
+			err := json.Unmarshal([]byte(test.data), &s)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, s)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// CollectionDocumentImport interface for bulk importing documents into a collection.
+// https://www.arangodb.com/docs/stable/http/bulk-imports.html
+type CollectionDocumentImport interface {
+	// ImportDocuments reads the documents from the given source and imports them into the collection.
+	// The source is split into chunks (see CollectionDocumentImportOptions.ChunkSize and ChunkBytes), and up to
+	// CollectionDocumentImportOptions.Concurrency chunks are sent to the server at the same time.
+	// The statistics of all chunks are summed up in the response.
+	// Documents which can not be imported do not stop the import, they are counted as errors and,
+	// when CollectionDocumentImportOptions.Details is set, described in the response.
+	// When a chunk can not be imported at all, no more chunks are sent and the error is returned together with
+	// the statistics of the chunks which have been imported so far.
+	ImportDocuments(ctx context.Context, source ImportSource, opts *CollectionDocumentImportOptions) (CollectionDocumentImportResponse, error)
+}
+
+// ImportSource provides the values which are imported by CollectionDocumentImport.ImportDocuments.
+// Use NewImportSourceReader, NewImportSourceChannel or NewImportSourceIterator to create one.
+type ImportSource interface {
+	// Type returns the type of the values returned by Next, ImportTypeDocuments or ImportTypeArrays.
+	Type() ImportType
+
+	// Next returns the next value encoded as a single line of JSON.
+	// For ImportTypeArrays the first value is an array with the attribute names, and each next value is an array
+	// with the attribute values of one document.
+	// It returns io.EOF when there are no more values.
+	Next() ([]byte, error)
+}
+
+// ImportType describes the format of the imported values.
+type ImportType string
+
+const (
+	// ImportTypeDocuments - each value is a JSON object, one per line (JSON lines).
+	ImportTypeDocuments ImportType = "documents"
+	// ImportTypeList - the input is a single JSON array of objects.
+	// It is only accepted by NewImportSourceReader, which returns the objects one by one as ImportTypeDocuments.
+	ImportTypeList ImportType = "list"
+	// ImportTypeArrays - each value is a JSON array, one per line.
+	// The first array holds the attribute names and each next array holds the attribute values of one document.
+	ImportTypeArrays ImportType = "arrays"
+)
+
+// ImportOnDuplicate controls what happens when a document violates a unique key constraint.
+type ImportOnDuplicate string
+
+const (
+	// ImportOnDuplicateError will not import the document, it is counted as an error. This is the default.
+	ImportOnDuplicateError ImportOnDuplicate = "error"
+	// ImportOnDuplicateUpdate will update the existing document with the imported attributes.
+	ImportOnDuplicateUpdate ImportOnDuplicate = "update"
+	// ImportOnDuplicateReplace will replace the existing document with the imported one.
+	ImportOnDuplicateReplace ImportOnDuplicate = "replace"
+	// ImportOnDuplicateIgnore will not import the document, it is counted as ignored.
+	ImportOnDuplicateIgnore ImportOnDuplicate = "ignore"
+)
+
+type CollectionDocumentImportOptions struct {
+	// FromPrefix is an optional prefix for the values in _from attributes.
+	// It allows to specify just the keys in _from.
+	FromPrefix *string
+
+	// ToPrefix is an optional prefix for the values in _to attributes.
+	// It allows to specify just the keys in _to.
+	ToPrefix *string
+
+	// Overwrite if set to true removes all documents from the collection before the import.
+	// The indexes of the collection are kept.
+	Overwrite *bool
+
+	// OnDuplicate controls what happens when a document violates a unique key constraint.
+	OnDuplicate *ImportOnDuplicate
+
+	// Complete if set to true makes a chunk fail when any of its documents can not be imported.
+	// Note that the chunks which have been imported before are not rolled back.
+	Complete *bool
+
+	// WaitForSync waits until the documents have been synced to disk.
+	WaitForSync *bool
+
+	// Details if set to true returns the details about each document which could not be imported.
+	Details *bool
+
+	// ChunkSize is the maximum number of documents sent in one request. The default is 1000.
+	ChunkSize int
+
+	// ChunkBytes is the maximum size of a request body in bytes.
+	// A chunk holds at least one document, so a single larger document is still sent. The default is no limit.
+	ChunkBytes int
+
+	// Concurrency is the maximum number of chunks which are sent at the same time. The default is 1.
+	Concurrency int
+}
+
+func (c *CollectionDocumentImportOptions) modifyRequest(r connection.Request) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.FromPrefix != nil {
+		r.AddQuery("fromPrefix", *c.FromPrefix)
+	}
+
+	if c.ToPrefix != nil {
+		r.AddQuery("toPrefix", *c.ToPrefix)
+	}
+
+	if c.OnDuplicate != nil {
+		r.AddQuery("onDuplicate", string(*c.OnDuplicate))
+	}
+
+	if c.Complete != nil {
+		r.AddQuery("complete", boolToString(*c.Complete))
+	}
+
+	if c.WaitForSync != nil {
+		r.AddQuery("waitForSync", boolToString(*c.WaitForSync))
+	}
+
+	if c.Details != nil {
+		r.AddQuery("details", boolToString(*c.Details))
+	}
+
+	return nil
+}
+
+// ImportDocumentStatistics holds the statistics of an import.
+type ImportDocumentStatistics struct {
+	// Created holds the number of imported documents.
+	Created int64 `json:"created,omitempty"`
+	// Errors holds the number of documents which were not imported due to an error.
+	Errors int64 `json:"errors,omitempty"`
+	// Empty holds the number of empty lines found in the input.
+	Empty int64 `json:"empty,omitempty"`
+	// Updated holds the number of updated or replaced documents (when OnDuplicate is update or replace).
+	Updated int64 `json:"updated,omitempty"`
+	// Ignored holds the number of documents which were ignored (when OnDuplicate is ignore).
+	Ignored int64 `json:"ignored,omitempty"`
+}
+
+// ImportDocumentError describes a document which could not be imported.
+type ImportDocumentError struct {
+	// Index is the zero-based position of the document in the source, or -1 when it is not known.
+	// For ImportTypeArrays the line with the attribute names is not counted.
+	Index int64
+	// Message is the message returned by the server.
+	Message string
+}
+
+type CollectionDocumentImportResponse struct {
+	ImportDocumentStatistics
+
+	// Chunks holds the number of imported chunks.
+	Chunks int
+
+	// Details holds the documents which could not be imported sorted by their position in the source.
+	// It is only filled when CollectionDocumentImportOptions.Details is set.
+	Details []ImportDocumentError
+}
@@ -0,0 +1,308 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+const (
+	defaultImportChunkSize   = 1000
+	defaultImportConcurrency = 1
+)
+
+// importDetailPattern matches the details of documents which could not be imported, e.g.
+// "at position 3: creating document failed with error 'unique constraint violated', offending document: {...}".
+var importDetailPattern = regexp.MustCompile(`^at position (\d+):`)
+
+func newCollectionDocumentImport(collection *collection) *collectionDocumentImport {
+	return &collectionDocumentImport{
+		collection: collection,
+	}
+}
+
+var _ CollectionDocumentImport = &collectionDocumentImport{}
+
+type collectionDocumentImport struct {
+	collection *collection
+}
+
+// importChunk is a part of the source which is sent to the server in a single request.
+type importChunk struct {
+	// offset is the position in the source of the first document in the chunk.
+	offset int64
+	// count is the number of documents in the chunk.
+	count int
+	body  []byte
+}
+
+func (c collectionDocumentImport) ImportDocuments(ctx context.Context, source ImportSource,
+	opts *CollectionDocumentImportOptions) (CollectionDocumentImportResponse, error) {
+	var result CollectionDocumentImportResponse
+
+	if source == nil {
+		return result, errors.WithStack(shared.InvalidArgumentError{Message: "import source must be set"})
+	}
+
+	if t := source.Type(); t != ImportTypeDocuments && t != ImportTypeArrays {
+		return result, errors.WithStack(shared.InvalidArgumentError{Message: "unsupported import source type: " + string(t)})
+	}
+
+	if opts != nil && opts.Overwrite != nil && *opts.Overwrite {
+		// Each chunk is a separate request, so the collection is truncated once instead of overwriting it by each chunk.
+		if err := c.collection.Truncate(ctx); err != nil {
+			return result, err
+		}
+	}
+
+	concurrency := defaultImportConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mutex    sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		chunks   = make(chan importChunk)
+	)
+
+	fail := func(err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if firstErr == nil {
+			firstErr = err
+		}
+		cancel()
+	}
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for chunk := range chunks {
+				_, stats, details, err := sendImportChunk(ctx, c.collection.connection(), c.collection.db.url("_api", "import"),
+					c.collection.name, source.Type(), chunk, opts, c.collection.withModifiers()...)
+				if err != nil {
+					fail(err)
+					return
+				}
+
+				mutex.Lock()
+				result.add(stats, details)
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	chunkSize, chunkBytes := defaultImportChunkSize, 0
+	if opts != nil {
+		if opts.ChunkSize > 0 {
+			chunkSize = opts.ChunkSize
+		}
+		chunkBytes = opts.ChunkBytes
+	}
+
+	err := splitImportSource(ctx, source, 0, chunkSize, chunkBytes, chunks)
+	close(chunks)
+	if err != nil {
+		fail(err)
+	}
+	wg.Wait()
+
+	sort.SliceStable(result.Details, func(i, j int) bool {
+		return result.Details[i].Index < result.Details[j].Index
+	})
+
+	return result, firstErr
+}
+
+// sendImportChunk sends a single chunk to the import API at the given URL.
+// The response is returned also with an ArangoError, so the caller can inspect its headers.
+func sendImportChunk(ctx context.Context, conn connection.Connection, url, collectionName string, importType ImportType,
+	chunk importChunk, opts *CollectionDocumentImportOptions,
+	mods ...connection.RequestModifier) (connection.Response, ImportDocumentStatistics, []ImportDocumentError, error) {
+	var response struct {
+		shared.ResponseStruct    `json:",inline"`
+		ImportDocumentStatistics `json:",inline"`
+		Details                  []string `json:"details,omitempty"`
+	}
+
+	// The given modifiers may be shared, so they are copied instead of appended to.
+	mods = append(append([]connection.RequestModifier{}, mods...),
+		connection.WithQuery("collection", collectionName),
+		// Positions of documents in the details are counted from the given line, so they refer to the whole source.
+		connection.WithQuery("line", strconv.FormatInt(chunk.offset, 10)),
+		withEncodedBody,
+		func(r connection.Request) error {
+			if importType == ImportTypeDocuments {
+				r.AddQuery("type", string(ImportTypeDocuments))
+			}
+			return nil
+		},
+		opts.modifyRequest,
+	)
+
+	resp, err := connection.CallPost(ctx, conn, url, &response, chunk.body, mods...)
+	if err != nil {
+		return nil, ImportDocumentStatistics{}, nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated:
+		return resp, response.ImportDocumentStatistics, parseImportDetails(response.Details), nil
+	default:
+		return resp, ImportDocumentStatistics{}, nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+// withEncodedBody sends the body, which is already encoded as JSON, as it is, whatever the content type
+// of the connection is. The server decodes all bodies as JSON, except for VelocyPack.
+func withEncodedBody(r connection.Request) error {
+	r.AddHeader(connection.ContentType, connection.PlainText)
+	return nil
+}
+
+// add sums up the statistics of an imported chunk.
+func (c *CollectionDocumentImportResponse) add(stats ImportDocumentStatistics, details []ImportDocumentError) {
+	c.Created += stats.Created
+	c.Errors += stats.Errors
+	c.Empty += stats.Empty
+	c.Updated += stats.Updated
+	c.Ignored += stats.Ignored
+	c.Chunks++
+	c.Details = append(c.Details, details...)
+}
+
+// splitImportSource reads the values from the source and sends them in chunks to the given channel.
+// The first offset values of the source are skipped.
+func splitImportSource(ctx context.Context, source ImportSource, offset int64, chunkSize, chunkBytes int,
+	chunks chan<- importChunk) error {
+	// The line with attribute names must be repeated in each chunk.
+	var header []byte
+	if source.Type() == ImportTypeArrays {
+		line, err := source.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		header = append(line, '\n')
+	}
+
+	for i := int64(0); i < offset; i++ {
+		if _, err := source.Next(); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	var (
+		count int
+		body  bytes.Buffer
+	)
+
+	flush := func() error {
+		if count == 0 {
+			return nil
+		}
+
+		chunk := importChunk{offset: offset, count: count, body: append([]byte(nil), body.Bytes()...)}
+		select {
+		case chunks <- chunk:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		offset += int64(count)
+		count = 0
+		body.Reset()
+		return nil
+	}
+
+	for {
+		line, err := source.Next()
+		if err == io.EOF {
+			return flush()
+		} else if err != nil {
+			return err
+		}
+
+		if count > 0 && chunkBytes > 0 && body.Len()+len(line)+1 > chunkBytes {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+
+		if count == 0 {
+			body.Write(header)
+		}
+		body.Write(line)
+		body.WriteByte('\n')
+		count++
+
+		if count >= chunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// parseImportDetails converts the details returned by the server.
+// The server counts the lines starting from 1 after the given offset, so the index is one less than the position.
+func parseImportDetails(details []string) []ImportDocumentError {
+	if len(details) == 0 {
+		return nil
+	}
+
+	result := make([]ImportDocumentError, 0, len(details))
+	for _, detail := range details {
+		index := int64(-1)
+		if match := importDetailPattern.FindStringSubmatch(detail); match != nil {
+			if position, err := strconv.ParseInt(match[1], 10, 64); err == nil && position > 0 {
+				index = position - 1
+			}
+		}
+
+		result = append(result, ImportDocumentError{Index: index, Message: detail})
+	}
+
+	return result
+}
@@ -0,0 +1,262 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readImportSource(t *testing.T, source ImportSource) []string {
+	var values []string
+	for {
+		v, err := source.Next()
+		if err == io.EOF {
+			return values
+		}
+		require.NoError(t, err)
+		values = append(values, string(v))
+	}
+}
+
+func TestImportSourceReader(t *testing.T) {
+	testCases := map[string]struct {
+		input      string
+		importType ImportType
+		sourceType ImportType
+		expected   []string
+	}{
+		"documents": {
+			input:      "{\"a\":1}\r\n\n  {\"a\":2}",
+			importType: ImportTypeDocuments,
+			sourceType: ImportTypeDocuments,
+			expected:   []string{`{"a":1}`, `{"a":2}`},
+		},
+		"arrays": {
+			input:      "[\"_key\",\"a\"]\n[\"k1\",1]\n[\"k2\",2]\n",
+			importType: ImportTypeArrays,
+			sourceType: ImportTypeArrays,
+			expected:   []string{`["_key","a"]`, `["k1",1]`, `["k2",2]`},
+		},
+		"list": {
+			input:      "[\n  {\n    \"a\": 1\n  },\n  {\"a\": [1, 2]}\n]",
+			importType: ImportTypeList,
+			sourceType: ImportTypeDocuments,
+			expected:   []string{`{"a":1}`, `{"a":[1,2]}`},
+		},
+		"empty list": {
+			input:      "[]",
+			importType: ImportTypeList,
+			sourceType: ImportTypeDocuments,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			source := NewImportSourceReader(strings.NewReader(tc.input), tc.importType)
+
+			assert.Equal(t, tc.sourceType, source.Type())
+			assert.Equal(t, tc.expected, readImportSource(t, source))
+		})
+	}
+
+	t.Run("list of arrays", func(t *testing.T) {
+		source := NewImportSourceReader(strings.NewReader(`[[1, 2]]`), ImportTypeList)
+
+		_, err := source.Next()
+		require.Error(t, err)
+	})
+}
+
+func TestImportSourceChannel(t *testing.T) {
+	type doc struct {
+		Key string `json:"_key"`
+	}
+
+	ch := make(chan doc)
+	go func() {
+		defer close(ch)
+		ch <- doc{Key: "a"}
+		ch <- doc{Key: "b"}
+	}()
+
+	source := NewImportSourceChannel(ch)
+
+	assert.Equal(t, ImportTypeDocuments, source.Type())
+	assert.Equal(t, []string{`{"_key":"a"}`, `{"_key":"b"}`}, readImportSource(t, source))
+}
+
+func TestSplitImportSource(t *testing.T) {
+	testCases := map[string]struct {
+		input      string
+		importType ImportType
+		offset     int64
+		chunkSize  int
+		chunkBytes int
+		expected   []importChunk
+	}{
+		"chunk size": {
+			input:      "{\"a\":1}\n{\"a\":2}\n{\"a\":3}\n",
+			importType: ImportTypeDocuments,
+			chunkSize:  2,
+			expected: []importChunk{
+				{offset: 0, count: 2, body: []byte("{\"a\":1}\n{\"a\":2}\n")},
+				{offset: 2, count: 1, body: []byte("{\"a\":3}\n")},
+			},
+		},
+		"chunk bytes": {
+			input:      "{\"a\":1}\n{\"a\":22}\n{\"a\":3}\n",
+			importType: ImportTypeDocuments,
+			chunkSize:  10,
+			chunkBytes: 10,
+			expected: []importChunk{
+				{offset: 0, count: 1, body: []byte("{\"a\":1}\n")},
+				{offset: 1, count: 1, body: []byte("{\"a\":22}\n")},
+				{offset: 2, count: 1, body: []byte("{\"a\":3}\n")},
+			},
+		},
+		"header is repeated": {
+			input:      "[\"a\"]\n[1]\n[2]\n[3]\n",
+			importType: ImportTypeArrays,
+			chunkSize:  2,
+			expected: []importChunk{
+				{offset: 0, count: 2, body: []byte("[\"a\"]\n[1]\n[2]\n")},
+				{offset: 2, count: 1, body: []byte("[\"a\"]\n[3]\n")},
+			},
+		},
+		"only header": {
+			input:      "[\"a\"]\n",
+			importType: ImportTypeArrays,
+			chunkSize:  2,
+		},
+		"offset": {
+			input:      "[\"a\"]\n[1]\n[2]\n[3]\n",
+			importType: ImportTypeArrays,
+			offset:     2,
+			chunkSize:  2,
+			expected: []importChunk{
+				{offset: 2, count: 1, body: []byte("[\"a\"]\n[3]\n")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			chunks := make(chan importChunk, 10)
+			source := NewImportSourceReader(strings.NewReader(tc.input), tc.importType)
+
+			require.NoError(t, splitImportSource(context.Background(), source, tc.offset, tc.chunkSize, tc.chunkBytes, chunks))
+			close(chunks)
+
+			var result []importChunk
+			for chunk := range chunks {
+				result = append(result, chunk)
+			}
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestParseImportDetails(t *testing.T) {
+	details := []string{
+		"at position 3: creating document failed with error 'unique constraint violated', offending document: {\"_key\":\"a\"}",
+		"invalid JSON type (expecting object, probably parse error), offending context: 1",
+	}
+
+	assert.Equal(t, []ImportDocumentError{
+		{Index: 2, Message: details[0]},
+		{Index: -1, Message: details[1]},
+	}, parseImportDetails(details))
+}
+
+func TestCollectionDocumentImport_ImportDocuments(t *testing.T) {
+	var (
+		mutex sync.Mutex
+		keys  []string
+	)
+
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/_db/db/_api/import", r.URL.Path)
+		assert.Equal(t, "users", r.URL.Query().Get("collection"))
+		assert.Equal(t, "documents", r.URL.Query().Get("type"))
+		assert.Equal(t, "true", r.URL.Query().Get("details"))
+
+		line, err := strconv.Atoi(r.URL.Query().Get("line"))
+		require.NoError(t, err)
+
+		var created int
+		var details []string
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			line++
+			// Documents with the key "dup" fail like on a unique constraint violation.
+			if bytes.Contains(scanner.Bytes(), []byte(`"dup"`)) {
+				details = append(details, fmt.Sprintf("at position %d: unique constraint violated", line))
+				continue
+			}
+
+			created++
+			mutex.Lock()
+			keys = append(keys, scanner.Text())
+			mutex.Unlock()
+		}
+
+		writeTestResponse(w, http.StatusCreated, map[string]interface{}{
+			"error": false, "created": created, "errors": len(details), "details": details,
+		})
+	}))
+
+	db := newDatabase(newTestClient(server.URL), "db")
+	col := newCollection(db, "users")
+
+	var input bytes.Buffer
+	for i := 0; i < 10; i++ {
+		key := strconv.Itoa(i)
+		if i == 3 || i == 7 {
+			key = "dup"
+		}
+		fmt.Fprintf(&input, "{\"_key\":%q}\n", key)
+	}
+
+	details := true
+	result, err := col.ImportDocuments(context.Background(), NewImportSourceReader(&input, ImportTypeDocuments),
+		&CollectionDocumentImportOptions{Details: &details, ChunkSize: 3, Concurrency: 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(8), result.Created)
+	assert.Equal(t, int64(2), result.Errors)
+	assert.Equal(t, 4, result.Chunks)
+	assert.Len(t, keys, 8)
+	require.Len(t, result.Details, 2)
+	assert.Equal(t, int64(3), result.Details[0].Index)
+	assert.Equal(t, int64(7), result.Details[1].Index)
+}
@@ -0,0 +1,151 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// NewImportSourceReader creates an ImportSource which reads the values from the given reader.
+// The importType describes the format of the input:
+// - ImportTypeDocuments: JSON objects, one per line,
+// - ImportTypeArrays: JSON arrays, one per line, the first one holds the attribute names,
+// - ImportTypeList: a single JSON array of objects.
+// Empty lines are skipped.
+func NewImportSourceReader(r io.Reader, importType ImportType) ImportSource {
+	if importType == ImportTypeList {
+		return &importSourceList{decoder: json.NewDecoder(r)}
+	}
+
+	return &importSourceLines{reader: bufio.NewReader(r), importType: importType}
+}
+
+// NewImportSourceChannel creates an ImportSource of ImportTypeDocuments which imports the values received from the
+// given channel until it is closed. Each value is marshalled to JSON.
+func NewImportSourceChannel[T any](ch <-chan T) ImportSource {
+	return NewImportSourceIterator(func() (T, error) {
+		v, ok := <-ch
+		if !ok {
+			return v, io.EOF
+		}
+
+		return v, nil
+	})
+}
+
+// NewImportSourceIterator creates an ImportSource of ImportTypeDocuments which imports the values returned by
+// the given function until it returns an error. The function returns io.EOF when there are no more values.
+// Each value is marshalled to JSON.
+func NewImportSourceIterator[T any](next func() (T, error)) ImportSource {
+	return importSourceIterator[T](next)
+}
+
+type importSourceIterator[T any] func() (T, error)
+
+func (i importSourceIterator[T]) Type() ImportType {
+	return ImportTypeDocuments
+}
+
+func (i importSourceIterator[T]) Next() ([]byte, error) {
+	v, err := i()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return data, nil
+}
+
+type importSourceLines struct {
+	reader     *bufio.Reader
+	importType ImportType
+}
+
+func (i *importSourceLines) Type() ImportType {
+	return i.importType
+}
+
+func (i *importSourceLines) Next() ([]byte, error) {
+	for {
+		line, err := i.reader.ReadBytes('\n')
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			return nil, err
+		}
+
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			return line, nil
+		}
+	}
+}
+
+type importSourceList struct {
+	decoder *json.Decoder
+	started bool
+}
+
+func (i *importSourceList) Type() ImportType {
+	return ImportTypeDocuments
+}
+
+func (i *importSourceList) Next() ([]byte, error) {
+	if !i.started {
+		if err := expectDelim(i.decoder, '[', "import list"); err != nil {
+			return nil, err
+		}
+		i.started = true
+	}
+
+	if !i.decoder.More() {
+		if err := expectDelim(i.decoder, ']', "import list"); err != nil {
+			return nil, err
+		}
+
+		return nil, io.EOF
+	}
+
+	var raw json.RawMessage
+	if err := i.decoder.Decode(&raw); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// The values are sent as JSON lines, so a pretty printed value must not contain any new line.
+	var b bytes.Buffer
+	if err := json.Compact(&b, raw); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if b.Len() == 0 || b.Bytes()[0] != '{' {
+		return nil, errors.WithStack(shared.InvalidArgumentError{Message: "import list must contain only objects"})
+	}
+
+	return b.Bytes(), nil
+}
@@ -53,6 +53,10 @@ type Cursor interface {
 
 	// Plan returns the query execution plan for this cursor.
 	Plan() CursorPlan
+
+	// Profile returns the duration of the query execution phases, when the query is profiled.
+	// Use NewQueryProfile to join the statistics of the plan nodes with the plan.
+	Profile() CursorProfile
 }
 
 // CursorBatch is returned from a query, used to iterate over a list of documents.
@@ -87,6 +91,10 @@ type CursorBatch interface {
 
 	// Plan returns the query execution plan for this cursor.
 	Plan() CursorPlan
+
+	// Profile returns the duration of the query execution phases, when the query is profiled.
+	// Use NewQueryProfile to join the statistics of the plan nodes with the plan.
+	Profile() CursorProfile
 }
 
 type CursorStats struct {
@@ -122,6 +130,8 @@ type CursorStats struct {
 	// This value will only be non-zero when reading from indexes that have an in-memory cache enabled,
 	// the query allows using the in-memory cache (i.e. using equality lookups on all index attributes) and the looked up values are not present in the cache.
 	CacheMisses uint64 `json:"cacheMisses,omitempty"`
+	// Nodes holds the execution statistics of each plan node, when the query is profiled with QuerySubOptions.Profile set to 2.
+	Nodes []CursorStatsNode `json:"nodes,omitempty"`
 }
 
 type cursorData struct {
@@ -134,6 +144,8 @@ type cursorData struct {
 		Stats CursorStats `json:"stats,omitempty"`
 		// Plan describes plan for a cursor.
 		Plan CursorPlan `json:"plan,omitempty"`
+		// Profile holds the duration of the query execution phases.
+		Profile CursorProfile `json:"profile,omitempty"`
 	} `json:"extra"`
 }
 
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -32,11 +33,27 @@ import (
 	"github.com/arangodb/go-driver/v2/connection"
 )
 
-func newCursor(db *database, endpoint string, data cursorData) *cursor {
+const (
+	defaultBatchRetryAttempts = 3
+	defaultBatchRetryDelay    = 100 * time.Millisecond
+)
+
+func newCursor(db *database, endpoint string, data cursorData, opts *QueryOptions) *cursor {
 	c := &cursor{
-		db:       db,
-		endpoint: endpoint,
-		data:     data,
+		db:                 db,
+		endpoint:           endpoint,
+		data:               data,
+		batchRetryAttempts: defaultBatchRetryAttempts,
+		batchRetryDelay:    defaultBatchRetryDelay,
+	}
+
+	if opts != nil {
+		if opts.BatchRetryAttempts != 0 {
+			c.batchRetryAttempts = opts.BatchRetryAttempts
+		}
+		if opts.BatchRetryDelay > 0 {
+			c.batchRetryDelay = opts.BatchRetryDelay
+		}
 	}
 
 	if data.NextBatchID != "" {
@@ -58,6 +75,11 @@ type cursor struct {
 	data      cursorData
 	lock      sync.Mutex
 	retryData *retryData
+
+	// batchRetryAttempts is the number of automatic retries of a batch read, which fails with a network error.
+	batchRetryAttempts int
+	// batchRetryDelay is the delay before the first automatic retry.
+	batchRetryDelay time.Duration
 }
 
 type retryData struct {
@@ -85,7 +107,8 @@ func (c *cursor) CloseWithContext(ctx context.Context) error {
 
 	url := c.db.url("_api", "cursor", c.data.ID)
 
-	resp, err := connection.CallDelete(ctx, c.db.connection(), url, &c.data, c.db.modifiers...)
+	resp, err := connection.CallWithEndpoint(ctx, c.db.connection(), c.endpoint, http.MethodDelete, url, &c.data,
+		c.db.modifiers...)
 	if err != nil {
 		return err
 	}
@@ -177,18 +200,43 @@ func (c *cursor) getNextBatch(ctx context.Context, retryBatchID string) error {
 		url = c.db.url("_api", "cursor", c.retryData.cursorID, retryBatchID)
 	}
 
+	// The batch can be fetched again only when it is identified by its ID.
+	retryable := c.data.NextBatchID != "" || retryBatchID != ""
+
 	// Update currentBatchID before fetching the next batch (no retry case)
 	if c.data.NextBatchID != "" && retryBatchID == "" {
 		c.retryData.currentBatchID = c.data.NextBatchID
 	}
 
-	resp, err := connection.CallPost(ctx, c.db.connection(), url, &c.data, nil, c.db.modifiers...)
+	delay := c.batchRetryDelay
+	for attempt := 0; ; attempt++ {
+		err := c.fetchBatch(ctx, url)
+		if err == nil || !retryable || attempt >= c.batchRetryAttempts || !connection.IsNetworkError(err) {
+			return err
+		}
+
+		select {
+		case <-time.After(delay):
+			delay *= 2
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// fetchBatch reads the batch from the coordinator which owns the cursor.
+// The cursor data is changed only when the whole batch is read.
+func (c *cursor) fetchBatch(ctx context.Context, url string) error {
+	data := c.data
+	resp, err := connection.CallWithEndpoint(ctx, c.db.connection(), c.endpoint, http.MethodPost, url, &data,
+		c.db.modifiers...)
 	if err != nil {
 		return err
 	}
 
 	switch code := resp.Code(); code {
 	case http.StatusOK:
+		c.data = data
 		return nil
 	default:
 		return shared.NewResponseStruct().AsArangoErrorWithCode(code)
@@ -207,3 +255,7 @@ func (c *cursor) Statistics() CursorStats {
 func (c *cursor) Plan() CursorPlan {
 	return c.data.Extra.Plan
 }
+
+func (c *cursor) Profile() CursorProfile {
+	return c.data.Extra.Profile
+}
@@ -0,0 +1,201 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// cursorTestServers serves a cursor with the batches [1,2], [3,4] and [5] from two coordinators.
+// The first failures requests for the second batch fail, by dropping the connection or with the failureCode.
+type cursorTestServers struct {
+	servers     []*httptest.Server
+	allowRetry  bool
+	failures    int
+	failureCode int
+
+	lock     sync.Mutex
+	owner    string
+	attempts int
+	handled  []string
+}
+
+func newCursorTestServers(t *testing.T, allowRetry bool, failures, failureCode int) *cursorTestServers {
+	s := &cursorTestServers{allowRetry: allowRetry, failures: failures, failureCode: failureCode}
+
+	for i := 0; i < 2; i++ {
+		var server *httptest.Server
+		server = newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			s.handle(t, server.URL, w, r)
+		}))
+		s.servers = append(s.servers, server)
+	}
+
+	return s
+}
+
+func (s *cursorTestServers) handle(t *testing.T, server string, w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	w.Header().Set("Content-Type", connection.ApplicationJSON)
+	batch := func(result []int, hasMore bool, nextBatchID string) {
+		response := map[string]interface{}{"id": "c1", "result": result, "hasMore": hasMore}
+		if s.allowRetry && nextBatchID != "" {
+			response["nextBatchId"] = nextBatchID
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}
+
+	if r.URL.Path == "/_db/db/_api/cursor" && s.owner == "" {
+		s.owner = server
+		w.WriteHeader(http.StatusCreated)
+		batch([]int{1, 2}, true, "2")
+		return
+	}
+
+	s.handled = append(s.handled, r.Method+" "+r.URL.Path+" "+server)
+
+	switch r.URL.Path {
+	case "/_db/db/_api/cursor/c1/2", "/_db/db/_api/cursor/c1":
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusAccepted)
+			batch(nil, false, "")
+			return
+		}
+
+		s.attempts++
+		if s.attempts <= s.failures {
+			if s.failureCode != 0 {
+				w.WriteHeader(s.failureCode)
+				return
+			}
+
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+			return
+		}
+		batch([]int{3, 4}, true, "3")
+	case "/_db/db/_api/cursor/c1/3":
+		batch([]int{5}, false, "")
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *cursorTestServers) database() Database {
+	return newDatabase(newTestClient(s.servers[0].URL, s.servers[1].URL), "db")
+}
+
+func TestCursor_BatchRetry(t *testing.T) {
+	testCases := map[string]struct {
+		allowRetry       bool
+		failures         int
+		failureCode      int
+		retryAttempts    int
+		expectedAttempts int
+		expectedErr      bool
+		networkErr       bool
+	}{
+		"no failures": {
+			allowRetry:       true,
+			expectedAttempts: 1,
+		},
+		"retried after dropped connections": {
+			allowRetry:       true,
+			failures:         2,
+			expectedAttempts: 3,
+		},
+		"retries exhausted": {
+			allowRetry:       true,
+			failures:         3,
+			retryAttempts:    1,
+			expectedAttempts: 2,
+			expectedErr:      true,
+			networkErr:       true,
+		},
+		"retries disabled": {
+			allowRetry:       true,
+			failures:         1,
+			retryAttempts:    -1,
+			expectedAttempts: 1,
+			expectedErr:      true,
+			networkErr:       true,
+		},
+		"not retried without allowRetry": {
+			failures:         1,
+			expectedAttempts: 1,
+			expectedErr:      true,
+			networkErr:       true,
+		},
+		"server errors are not retried": {
+			allowRetry:       true,
+			failures:         1,
+			failureCode:      http.StatusInternalServerError,
+			expectedAttempts: 1,
+			expectedErr:      true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			servers := newCursorTestServers(t, tc.allowRetry, tc.failures, tc.failureCode)
+
+			ctx := context.Background()
+			cursor, err := servers.database().Query(ctx, "FOR i IN 1..5 RETURN i", &QueryOptions{
+				BatchSize:          2,
+				Options:            QuerySubOptions{AllowRetry: tc.allowRetry},
+				BatchRetryAttempts: tc.retryAttempts,
+				BatchRetryDelay:    time.Millisecond,
+			})
+			require.NoError(t, err)
+
+			docs, err := ReadAll[int](ctx, cursor)
+			if tc.expectedErr {
+				require.Error(t, err)
+				assert.Equal(t, tc.networkErr, connection.IsNetworkError(err))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, []int{1, 2, 3, 4, 5}, docs)
+			}
+
+			servers.lock.Lock()
+			defer servers.lock.Unlock()
+
+			assert.Equal(t, tc.expectedAttempts, servers.attempts)
+			for _, handled := range servers.handled {
+				assert.Contains(t, handled, servers.owner, "request must be sent to the coordinator which owns the cursor")
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+// QueryAll runs the query and reads all documents of the result.
+func QueryAll[T any](ctx context.Context, db DatabaseQuery, query string, opts *QueryOptions) ([]T, error) {
+	cursor, err := db.Query(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadAll[T](ctx, cursor)
+}
+
+// QueryOne runs the query and reads the first document of the result.
+// If the result is empty, a NoMoreDocumentsError is returned, use shared.IsNoMoreDocuments to check for it.
+func QueryOne[T any](ctx context.Context, db DatabaseQuery, query string, opts *QueryOptions) (T, error) {
+	var result T
+
+	cursor, err := db.Query(ctx, query, opts)
+	if err != nil {
+		return result, err
+	}
+	defer cursor.Close()
+
+	if _, err := cursor.ReadDocument(ctx, &result); err != nil {
+		var empty T
+		return empty, err
+	}
+
+	return result, nil
+}
+
+// ReadAll reads all remaining documents of the cursor.
+func ReadAll[T any](ctx context.Context, cursor Cursor) ([]T, error) {
+	result := make([]T, 0, cursor.Count())
+
+	var readErr error
+	Iter[T](ctx, cursor)(func(doc T, err error) bool {
+		if err != nil {
+			readErr = err
+			return false
+		}
+
+		result = append(result, doc)
+		return true
+	})
+
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	return result, nil
+}
+
+// Iter returns an iterator over the remaining documents of the cursor.
+// The iterator has the form of range-over-func iterators, so with Go 1.23 or newer it can be used as:
+//
+//	for doc, err := range arangodb.Iter[MyDocument](ctx, cursor) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// With older versions of Go the iterator is called with the loop body:
+//
+//	arangodb.Iter[MyDocument](ctx, cursor)(func(doc MyDocument, err error) bool { ...; return true })
+//
+// An error stops the iteration after it is yielded. It is also returned when the context is done.
+// When the iteration stops before all documents are read, the cursor is closed to release it on the server.
+func Iter[T any](ctx context.Context, cursor Cursor) func(yield func(T, error) bool) {
+	return func(yield func(T, error) bool) {
+		for cursor.HasMore() {
+			if err := ctx.Err(); err != nil {
+				stopIteration(cursor, yield, err)
+				return
+			}
+
+			var doc T
+			if _, err := cursor.ReadDocument(ctx, &doc); err != nil {
+				stopIteration(cursor, yield, err)
+				return
+			}
+
+			if !yield(doc, nil) {
+				cursor.Close()
+				return
+			}
+		}
+	}
+}
+
+// IterBatch returns an iterator over the documents of the batch cursor, see Iter for its usage.
+// QueryBatch reads the first batch of documents into its result argument, it is passed as firstBatch.
+// The next batches are read when the documents of the previous batch are consumed.
+func IterBatch[T any](ctx context.Context, cursor CursorBatch, firstBatch []T) func(yield func(T, error) bool) {
+	return func(yield func(T, error) bool) {
+		batch := firstBatch
+		for {
+			for _, doc := range batch {
+				if err := ctx.Err(); err != nil {
+					stopIteration(cursor, yield, err)
+					return
+				}
+
+				if !yield(doc, nil) {
+					cursor.Close()
+					return
+				}
+			}
+
+			if !cursor.HasMoreBatches() {
+				return
+			}
+
+			batch = nil
+			if err := cursor.ReadNextBatch(ctx, &batch); err != nil {
+				stopIteration(cursor, yield, err)
+				return
+			}
+		}
+	}
+}
+
+// Stream reads the remaining documents of the cursor in a separate goroutine and sends them to the returned channel.
+// The channel is buffered with the given size. At most one error is sent to the error channel.
+// Both channels are closed when all documents are sent, an error occurs or the context is done.
+// A consumer which stops receiving early must cancel the context, so the cursor is closed and the goroutine exits.
+func Stream[T any](ctx context.Context, cursor Cursor, buffer int) (<-chan T, <-chan error) {
+	return stream(ctx, Iter[T](ctx, cursor), buffer)
+}
+
+// StreamBatch is Stream for a batch cursor. See IterBatch for the firstBatch argument.
+func StreamBatch[T any](ctx context.Context, cursor CursorBatch, firstBatch []T, buffer int) (<-chan T, <-chan error) {
+	return stream(ctx, IterBatch(ctx, cursor, firstBatch), buffer)
+}
+
+func stream[T any](ctx context.Context, iter func(yield func(T, error) bool), buffer int) (<-chan T, <-chan error) {
+	docs := make(chan T, buffer)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(docs)
+
+		iter(func(doc T, err error) bool {
+			if err != nil {
+				errs <- err
+				return false
+			}
+
+			select {
+			case docs <- doc:
+				return true
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return false
+			}
+		})
+	}()
+
+	return docs, errs
+}
+
+// stopIteration closes the cursor and yields the error which stopped the iteration.
+// The cursor may be already released on the server, so the error of closing it is ignored.
+func stopIteration[T any](cursor interface{ Close() error }, yield func(T, error) bool, err error) {
+	cursor.Close()
+	var empty T
+	yield(empty, err)
+}
@@ -0,0 +1,257 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// testCursor is a Cursor over documents in memory, which fails when the document with failAt index is read.
+type testCursor struct {
+	Cursor
+
+	docs   []string
+	next   int
+	failAt int
+	closed bool
+}
+
+func newTestCursor(docs ...string) *testCursor {
+	return &testCursor{docs: docs, failAt: -1}
+}
+
+func (c *testCursor) HasMore() bool {
+	return !c.closed && c.next < len(c.docs)
+}
+
+func (c *testCursor) ReadDocument(_ context.Context, result interface{}) (DocumentMeta, error) {
+	if !c.HasMore() {
+		return DocumentMeta{}, shared.NoMoreDocumentsError{}
+	}
+	if c.next == c.failAt {
+		return DocumentMeta{}, errors.New("connection lost")
+	}
+
+	c.next++
+	return DocumentMeta{}, json.Unmarshal([]byte(c.docs[c.next-1]), result)
+}
+
+func (c *testCursor) Count() int64 {
+	return int64(len(c.docs))
+}
+
+func (c *testCursor) Close() error {
+	c.closed = true
+	return nil
+}
+
+// testCursorBatch is a CursorBatch over batches in memory.
+type testCursorBatch struct {
+	CursorBatch
+
+	batches []string
+	closed  bool
+}
+
+func (c *testCursorBatch) HasMoreBatches() bool {
+	return !c.closed && len(c.batches) > 0
+}
+
+func (c *testCursorBatch) ReadNextBatch(_ context.Context, result interface{}) error {
+	if !c.HasMoreBatches() {
+		return shared.NoMoreDocumentsError{}
+	}
+
+	batch := c.batches[0]
+	c.batches = c.batches[1:]
+	return json.Unmarshal([]byte(batch), result)
+}
+
+func (c *testCursorBatch) Close() error {
+	c.closed = true
+	return nil
+}
+
+type testIterDoc struct {
+	Name string `json:"name"`
+}
+
+func TestReadAll(t *testing.T) {
+	t.Run("all documents", func(t *testing.T) {
+		docs, err := ReadAll[testIterDoc](context.Background(), newTestCursor(`{"name":"a"}`, `{"name":"b"}`))
+		require.NoError(t, err)
+		assert.Equal(t, []testIterDoc{{Name: "a"}, {Name: "b"}}, docs)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		docs, err := ReadAll[int](context.Background(), newTestCursor())
+		require.NoError(t, err)
+		assert.Empty(t, docs)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		cursor := newTestCursor(`1`, `2`, `3`)
+		cursor.failAt = 1
+
+		_, err := ReadAll[int](context.Background(), cursor)
+		require.EqualError(t, err, "connection lost")
+		assert.True(t, cursor.closed)
+	})
+}
+
+func TestIter(t *testing.T) {
+	t.Run("early exit closes cursor", func(t *testing.T) {
+		cursor := newTestCursor(`1`, `2`, `3`)
+
+		var values []int
+		Iter[int](context.Background(), cursor)(func(value int, err error) bool {
+			require.NoError(t, err)
+			values = append(values, value)
+			return len(values) < 2
+		})
+
+		assert.Equal(t, []int{1, 2}, values)
+		assert.True(t, cursor.closed)
+	})
+
+	t.Run("all documents do not close cursor", func(t *testing.T) {
+		cursor := newTestCursor(`1`, `2`)
+
+		var values []int
+		Iter[int](context.Background(), cursor)(func(value int, err error) bool {
+			require.NoError(t, err)
+			values = append(values, value)
+			return true
+		})
+
+		assert.Equal(t, []int{1, 2}, values)
+		assert.False(t, cursor.closed, "the server releases fully read cursors")
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		cursor := newTestCursor(`1`, `2`)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var errs []error
+		Iter[int](ctx, cursor)(func(_ int, err error) bool {
+			errs = append(errs, err)
+			return true
+		})
+
+		require.Len(t, errs, 1)
+		assert.Equal(t, context.Canceled, errs[0])
+		assert.True(t, cursor.closed)
+	})
+}
+
+func TestIterBatch(t *testing.T) {
+	t.Run("all batches", func(t *testing.T) {
+		cursor := &testCursorBatch{batches: []string{`[3,4]`, `[]`, `[5]`}}
+
+		var values []int
+		IterBatch(context.Background(), cursor, []int{1, 2})(func(value int, err error) bool {
+			require.NoError(t, err)
+			values = append(values, value)
+			return true
+		})
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, values)
+		assert.False(t, cursor.closed)
+	})
+
+	t.Run("early exit closes cursor", func(t *testing.T) {
+		cursor := &testCursorBatch{batches: []string{`[3,4]`, `[5]`}}
+
+		var values []int
+		IterBatch(context.Background(), cursor, []int{1, 2})(func(value int, err error) bool {
+			values = append(values, value)
+			return value < 3
+		})
+
+		assert.Equal(t, []int{1, 2, 3}, values)
+		assert.True(t, cursor.closed)
+	})
+
+	t.Run("invalid batch", func(t *testing.T) {
+		cursor := &testCursorBatch{batches: []string{`[3,"x"]`}}
+
+		var err error
+		IterBatch(context.Background(), cursor, []int{1})(func(_ int, e error) bool {
+			err = e
+			return e == nil
+		})
+
+		require.Error(t, err)
+		assert.True(t, cursor.closed)
+	})
+}
+
+func TestStream(t *testing.T) {
+	t.Run("all documents", func(t *testing.T) {
+		docs, errs := Stream[int](context.Background(), newTestCursor(`1`, `2`, `3`), 1)
+
+		var values []int
+		for value := range docs {
+			values = append(values, value)
+		}
+
+		assert.Equal(t, []int{1, 2, 3}, values)
+		assert.NoError(t, <-errs)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		cursor := newTestCursor(`1`, `2`, `3`)
+		cursor.failAt = 2
+		docs, errs := Stream[int](context.Background(), cursor, 0)
+
+		var values []int
+		for value := range docs {
+			values = append(values, value)
+		}
+
+		assert.Equal(t, []int{1, 2}, values)
+		assert.EqualError(t, <-errs, "connection lost")
+		assert.True(t, cursor.closed)
+	})
+
+	t.Run("canceled by consumer", func(t *testing.T) {
+		cursor := &testCursorBatch{batches: []string{`[2,3]`}}
+		ctx, cancel := context.WithCancel(context.Background())
+		docs, errs := StreamBatch(ctx, cursor, []int{1}, 0)
+
+		assert.Equal(t, 1, <-docs)
+		cancel()
+
+		assert.Equal(t, context.Canceled, <-errs)
+		_, ok := <-docs
+		assert.False(t, ok)
+		assert.True(t, cursor.closed)
+	})
+}
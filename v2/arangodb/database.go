@@ -43,4 +43,9 @@ type Database interface {
 	DatabaseQuery
 	DatabaseView
 	DatabaseAnalyzer
+	DatabaseGraph
+	DatabasePregel
+	DatabaseFoxx
+	DatabaseAQLFunction
+	DatabaseTask
 }
@@ -0,0 +1,78 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+// DatabaseAQLFunction manages the user-defined AQL functions of a database.
+// https://www.arangodb.com/docs/stable/http/aql-user-functions.html
+type DatabaseAQLFunction interface {
+	// AQLFunctions returns the user-defined AQL functions.
+	// When namespace is not empty, only the functions of the namespace (e.g. "myfunctions") are returned.
+	AQLFunctions(ctx context.Context, namespace string) ([]AQLFunction, error)
+
+	// RegisterAQLFunction registers the user-defined AQL function or replaces it when it already exists.
+	// It returns true when the function has been newly created.
+	RegisterAQLFunction(ctx context.Context, function AQLFunction) (bool, error)
+
+	// UnregisterAQLFunction removes the user-defined AQL function with the given fully qualified name.
+	// If the function does not exist, a NotFoundError is returned.
+	UnregisterAQLFunction(ctx context.Context, name string) error
+
+	// UnregisterAQLFunctionGroup removes all user-defined AQL functions of the given namespace.
+	// It returns the number of removed functions.
+	UnregisterAQLFunctionGroup(ctx context.Context, namespace string) (int, error)
+
+	// SyncAQLFunctions makes the user-defined AQL functions of the namespace match the given functions,
+	// which are keyed by their fully qualified names (e.g. "myfunctions::add").
+	// Missing and changed functions are registered, and functions which are not given are removed.
+	SyncAQLFunctions(ctx context.Context, namespace string, functions map[string]AQLFunctionCode) (AQLFunctionSyncResult, error)
+}
+
+// AQLFunctionCode is the implementation of a user-defined AQL function.
+type AQLFunctionCode struct {
+	// Code is the JavaScript code of the function, e.g. "function (a, b) { return a + b; }".
+	Code string `json:"code"`
+	// IsDeterministic should be set to true when the function always returns the same result for the same input.
+	IsDeterministic bool `json:"isDeterministic"`
+}
+
+// AQLFunction describes a user-defined AQL function.
+type AQLFunction struct {
+	// Name is the fully qualified name of the function, e.g. "myfunctions::add".
+	Name string `json:"name"`
+
+	AQLFunctionCode `json:",inline"`
+}
+
+// AQLFunctionSyncResult holds the names of the functions changed by DatabaseAQLFunction.SyncAQLFunctions.
+type AQLFunctionSyncResult struct {
+	// Created holds the names of the newly registered functions.
+	Created []string
+	// Replaced holds the names of the functions whose code has been replaced.
+	Replaced []string
+	// Removed holds the names of the removed functions.
+	Removed []string
+	// Unchanged holds the names of the functions which were already up to date.
+	Unchanged []string
+}
@@ -0,0 +1,216 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// aqlFunctionNamespaceSeparator separates the namespace from the name of a function.
+const aqlFunctionNamespaceSeparator = "::"
+
+func newDatabaseAQLFunction(db *database) *databaseAQLFunction {
+	return &databaseAQLFunction{
+		db: db,
+	}
+}
+
+var _ DatabaseAQLFunction = &databaseAQLFunction{}
+
+type databaseAQLFunction struct {
+	db *database
+}
+
+func (d databaseAQLFunction) AQLFunctions(ctx context.Context, namespace string) ([]AQLFunction, error) {
+	url := d.db.url("_api", "aqlfunction")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                []AQLFunction `json:"result,omitempty"`
+	}
+
+	// The modifiers of the database are shared, so they are copied instead of appended to.
+	mods := append([]connection.RequestModifier{}, d.db.modifiers...)
+	if namespace != "" {
+		mods = append(mods, connection.WithQuery("namespace", namespace))
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, mods...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Result, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseAQLFunction) RegisterAQLFunction(ctx context.Context, function AQLFunction) (bool, error) {
+	url := d.db.url("_api", "aqlfunction")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		IsNewlyCreated        bool `json:"isNewlyCreated,omitempty"`
+	}
+
+	resp, err := connection.CallPost(ctx, d.db.connection(), url, &response, function, d.db.modifiers...)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusCreated:
+		return response.IsNewlyCreated, nil
+	default:
+		return false, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseAQLFunction) UnregisterAQLFunction(ctx context.Context, name string) error {
+	_, err := d.unregister(ctx, name, false)
+	return err
+}
+
+func (d databaseAQLFunction) UnregisterAQLFunctionGroup(ctx context.Context, namespace string) (int, error) {
+	return d.unregister(ctx, namespace, true)
+}
+
+func (d databaseAQLFunction) unregister(ctx context.Context, name string, group bool) (int, error) {
+	url := d.db.url("_api", "aqlfunction", name)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		DeletedCount          int `json:"deletedCount,omitempty"`
+	}
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), url, &response,
+		append(append([]connection.RequestModifier{}, d.db.modifiers...), connection.WithQuery("group", boolToString(group)))...)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.DeletedCount, nil
+	default:
+		return 0, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseAQLFunction) SyncAQLFunctions(ctx context.Context, namespace string,
+	functions map[string]AQLFunctionCode) (AQLFunctionSyncResult, error) {
+	var result AQLFunctionSyncResult
+
+	if namespace == "" {
+		return result, errors.WithStack(shared.InvalidArgumentError{Message: "namespace of AQL functions must be set"})
+	}
+
+	// The names of functions are case-insensitive.
+	prefix := strings.ToUpper(namespace + aqlFunctionNamespaceSeparator)
+	for name := range functions {
+		if !strings.HasPrefix(strings.ToUpper(name), prefix) {
+			return result, errors.WithStack(shared.InvalidArgumentError{
+				Message: "AQL function " + name + " does not belong to the namespace " + namespace,
+			})
+		}
+	}
+
+	existing, err := d.AQLFunctions(ctx, namespace)
+	if err != nil {
+		return result, err
+	}
+
+	current := make(map[string]AQLFunction, len(existing))
+	for _, function := range existing {
+		current[strings.ToUpper(function.Name)] = function
+	}
+
+	// Functions are registered in a stable order, so the result is predictable.
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		code := functions[name]
+
+		function, found := current[strings.ToUpper(name)]
+		delete(current, strings.ToUpper(name))
+
+		if found && function.IsDeterministic == code.IsDeterministic && sameAQLFunctionCode(function.Code, code.Code) {
+			result.Unchanged = append(result.Unchanged, name)
+			continue
+		}
+
+		created, err := d.RegisterAQLFunction(ctx, AQLFunction{Name: name, AQLFunctionCode: code})
+		if err != nil {
+			return result, err
+		}
+
+		if created {
+			result.Created = append(result.Created, name)
+		} else {
+			result.Replaced = append(result.Replaced, name)
+		}
+	}
+
+	removed := make([]string, 0, len(current))
+	for _, function := range current {
+		removed = append(removed, function.Name)
+	}
+	sort.Strings(removed)
+
+	for _, name := range removed {
+		if err := d.UnregisterAQLFunction(ctx, name); err != nil && !shared.IsNotFound(err) {
+			return result, err
+		}
+		result.Removed = append(result.Removed, name)
+	}
+
+	return result, nil
+}
+
+// sameAQLFunctionCode returns true when the code stored on the server is the same as the given code.
+func sameAQLFunctionCode(stored, code string) bool {
+	stored, code = strings.TrimSpace(stored), strings.TrimSpace(code)
+	if stored == code {
+		return true
+	}
+
+	// The server may return the code wrapped in parentheses.
+	if strings.HasPrefix(stored, "(") && strings.HasSuffix(stored, ")") {
+		return strings.TrimSpace(stored[1:len(stored)-1]) == code
+	}
+
+	return false
+}
@@ -0,0 +1,47 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameAQLFunctionCode(t *testing.T) {
+	testCases := map[string]struct {
+		stored   string
+		code     string
+		expected bool
+	}{
+		"same":                {stored: "function (a) { return a; }", code: "function (a) { return a; }", expected: true},
+		"surrounding spaces":  {stored: "function (a) { return a; }\n", code: "  function (a) { return a; }", expected: true},
+		"wrapped by server":   {stored: "(function (a) { return a; }\n)", code: "function (a) { return a; }", expected: true},
+		"different":           {stored: "function (a) { return a; }", code: "function (a) { return a + 1; }"},
+		"wrapped & different": {stored: "(function (a) { return a; }\n)", code: "function (b) { return b; }"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, sameAQLFunctionCode(tc.stored, tc.code))
+		})
+	}
+}
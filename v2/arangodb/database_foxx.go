@@ -0,0 +1,253 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+)
+
+// DatabaseFoxx provides access to the Foxx services installed in a single database.
+// https://www.arangodb.com/docs/stable/http/foxx.html
+type DatabaseFoxx interface {
+	// FoxxServices returns the list of the services installed in the database.
+	FoxxServices(ctx context.Context, opts *FoxxListOptions) ([]FoxxServiceListItem, error)
+
+	// FoxxService returns detailed information about the service installed at the given mount path.
+	FoxxService(ctx context.Context, mount string) (FoxxService, error)
+
+	// InstallFoxxService installs a new service at the given mount path.
+	InstallFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxInstallOptions) (FoxxService, error)
+
+	// ReplaceFoxxService removes the service at the given mount path from the database and file system.
+	// Then it installs the new service at the same mount path.
+	ReplaceFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxReplaceOptions) (FoxxService, error)
+
+	// UpgradeFoxxService installs the given new service on top of the service currently installed at the given mount path.
+	// Unlike ReplaceFoxxService, the configuration and dependencies of the old service are kept.
+	UpgradeFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxReplaceOptions) (FoxxService, error)
+
+	// UninstallFoxxService removes the service at the given mount path from the database and file system.
+	UninstallFoxxService(ctx context.Context, mount string, opts *FoxxUninstallOptions) error
+
+	// FoxxServiceConfiguration returns the configuration options of the service at the given mount path.
+	FoxxServiceConfiguration(ctx context.Context, mount string) (map[string]FoxxConfigurationOption, error)
+
+	// UpdateFoxxServiceConfiguration updates the given configuration options of the service.
+	// Options which are not given keep their current values.
+	UpdateFoxxServiceConfiguration(ctx context.Context, mount string, values map[string]interface{}) (map[string]FoxxConfigurationOption, error)
+
+	// ReplaceFoxxServiceConfiguration replaces the configuration of the service.
+	// Options which are not given are reset to their default values.
+	ReplaceFoxxServiceConfiguration(ctx context.Context, mount string, values map[string]interface{}) (map[string]FoxxConfigurationOption, error)
+
+	// FoxxServiceDependencies returns the dependencies of the service at the given mount path.
+	FoxxServiceDependencies(ctx context.Context, mount string) (map[string]FoxxDependency, error)
+
+	// UpdateFoxxServiceDependencies updates the given dependencies of the service.
+	// A value is the mount path of the service which fulfills the dependency,
+	// or a list of mount paths when the dependency allows multiple services.
+	// Dependencies which are not given keep their current values.
+	UpdateFoxxServiceDependencies(ctx context.Context, mount string, values map[string]interface{}) (map[string]FoxxDependency, error)
+
+	// ReplaceFoxxServiceDependencies replaces the dependencies of the service.
+	// Dependencies which are not given are unset.
+	ReplaceFoxxServiceDependencies(ctx context.Context, mount string, values map[string]interface{}) (map[string]FoxxDependency, error)
+
+	// EnableFoxxServiceDevelopmentMode puts the service into development mode.
+	// While the service is in development mode, it is reloaded from the file system on every request.
+	EnableFoxxServiceDevelopmentMode(ctx context.Context, mount string) (FoxxService, error)
+
+	// DisableFoxxServiceDevelopmentMode puts the service into production mode.
+	DisableFoxxServiceDevelopmentMode(ctx context.Context, mount string) (FoxxService, error)
+
+	// FoxxServiceScripts returns the scripts of the service, mapped from the script names to their human-friendly names.
+	FoxxServiceScripts(ctx context.Context, mount string) (map[string]string, error)
+
+	// RunFoxxServiceScript runs the given script of the service.
+	// The args are passed to the script, and the exports of the script are unmarshalled into the result (can be nil).
+	RunFoxxServiceScript(ctx context.Context, mount, name string, args interface{}, result interface{}) error
+
+	// RunFoxxServiceTests runs the tests of the service, and unmarshalls the report into the result.
+	// For the default reporter the result can be *FoxxTestReport.
+	RunFoxxServiceTests(ctx context.Context, mount string, opts *FoxxTestOptions, result interface{}) error
+
+	// FoxxServiceReadme returns the content of the README file of the service.
+	// When the service does not have a README file, nil is returned.
+	FoxxServiceReadme(ctx context.Context, mount string) ([]byte, error)
+
+	// FoxxServiceSwagger returns the Swagger API description of the service, and unmarshalls it into the result.
+	FoxxServiceSwagger(ctx context.Context, mount string, result interface{}) error
+}
+
+// FoxxServiceSource describes where the service bundle is taken from.
+// Exactly one of the fields must be set.
+type FoxxServiceSource struct {
+	// Bundle is the zip archive of the service, which is streamed to the server.
+	Bundle io.Reader
+	// URL is the URL, from which the server downloads the zip archive of the service,
+	// or the absolute path of the zip archive or the directory of the service on the server's file system.
+	URL string
+}
+
+type FoxxListOptions struct {
+	// Whether system services should be excluded from the result.
+	ExcludeSystem *bool
+}
+
+type FoxxInstallOptions struct {
+	// Whether the service should be installed in development mode.
+	Development *bool
+	// Whether the "setup" script should be executed. Default is true.
+	Setup *bool
+	// Whether the service should be installed in the legacy compatibility mode.
+	Legacy *bool
+}
+
+// FoxxReplaceOptions are used when the service is replaced or upgraded.
+type FoxxReplaceOptions struct {
+	// Whether the "teardown" script of the old service should be executed.
+	// Default is true for the replacement, and false for the upgrade.
+	Teardown *bool
+	// Whether the "setup" script of the new service should be executed. Default is true.
+	Setup *bool
+	// Whether the service should be installed in the legacy compatibility mode.
+	Legacy *bool
+	// If true, the service is installed even if no service is installed at the given mount path.
+	Force *bool
+}
+
+type FoxxUninstallOptions struct {
+	// Whether the "teardown" script should be executed. Default is true.
+	Teardown *bool
+}
+
+type FoxxTestOptions struct {
+	// Reporter is the test reporter to use: "default", "suite", "stream", "xunit" or "tap".
+	Reporter string
+	// Whether the output should be in the format of the reporter instead of JSON (the non-default reporters only).
+	Idiomatic *bool
+	// Filter is a text, which only the names of the tests to run must contain.
+	Filter string
+}
+
+type FoxxServiceListItem struct {
+	// Mount is the mount path of the service.
+	Mount string `json:"mount"`
+	// Name is the name of the service from the manifest.
+	Name string `json:"name,omitempty"`
+	// Version is the version of the service from the manifest.
+	Version string `json:"version,omitempty"`
+	// Provides contains the service dependency names provided by the service.
+	Provides map[string]interface{} `json:"provides,omitempty"`
+	// Development is true if the service is running in development mode.
+	Development bool `json:"development"`
+	// Legacy is true if the service is running in the legacy compatibility mode.
+	Legacy bool `json:"legacy"`
+}
+
+type FoxxService struct {
+	// Mount is the mount path of the service.
+	Mount string `json:"mount"`
+	// Path is the path of the service on the server's file system.
+	Path string `json:"path,omitempty"`
+	// Name is the name of the service from the manifest.
+	Name string `json:"name,omitempty"`
+	// Version is the version of the service from the manifest.
+	Version string `json:"version,omitempty"`
+	// Development is true if the service is running in development mode.
+	Development bool `json:"development"`
+	// Legacy is true if the service is running in the legacy compatibility mode.
+	Legacy bool `json:"legacy"`
+	// Manifest is the normalized manifest of the service.
+	Manifest map[string]interface{} `json:"manifest,omitempty"`
+	// Checksum is the checksum of the service bundle.
+	Checksum string `json:"checksum,omitempty"`
+	// Options contains the current configuration and dependencies of the service.
+	Options *FoxxServiceOptions `json:"options,omitempty"`
+}
+
+type FoxxServiceOptions struct {
+	Configuration map[string]interface{} `json:"configuration,omitempty"`
+	Dependencies  map[string]interface{} `json:"dependencies,omitempty"`
+}
+
+type FoxxConfigurationOption struct {
+	// Title is the human-friendly name of the option.
+	Title string `json:"title,omitempty"`
+	// Description of the option.
+	Description string `json:"description,omitempty"`
+	// Type is the type of the option, e.g. "string", "int", "boolean", "json".
+	Type string `json:"type,omitempty"`
+	// Default is the default value of the option.
+	Default interface{} `json:"default,omitempty"`
+	// Required is true if the option must be set.
+	Required bool `json:"required"`
+	// Current is the current value of the option.
+	Current interface{} `json:"current,omitempty"`
+	// CurrentRaw is the current value of the option before it was converted to the type of the option.
+	CurrentRaw interface{} `json:"currentRaw,omitempty"`
+}
+
+type FoxxDependency struct {
+	// Name is the service dependency name, which must be provided by the service that fulfills the dependency.
+	Name string `json:"name,omitempty"`
+	// Title is the human-friendly name of the dependency.
+	Title string `json:"title,omitempty"`
+	// Description of the dependency.
+	Description string `json:"description,omitempty"`
+	// Version is the required version range of the service that fulfills the dependency.
+	Version string `json:"version,omitempty"`
+	// Required is true if the dependency must be set.
+	Required bool `json:"required"`
+	// Multiple is true if the dependency can be fulfilled by multiple services.
+	Multiple bool `json:"multiple"`
+	// Current is the mount path (or the list of mount paths) of the service(s) that fulfill the dependency.
+	Current interface{} `json:"current,omitempty"`
+}
+
+// FoxxTestReport is the report of the tests, which is returned by the default test reporter.
+type FoxxTestReport struct {
+	Stats    FoxxTestStats    `json:"stats"`
+	Tests    []FoxxTestResult `json:"tests,omitempty"`
+	Pending  []FoxxTestResult `json:"pending,omitempty"`
+	Failures []FoxxTestResult `json:"failures,omitempty"`
+	Passes   []FoxxTestResult `json:"passes,omitempty"`
+}
+
+type FoxxTestStats struct {
+	Suites   int    `json:"suites"`
+	Tests    int    `json:"tests"`
+	Passes   int    `json:"passes"`
+	Pending  int    `json:"pending"`
+	Failures int    `json:"failures"`
+	Start    string `json:"start,omitempty"`
+	End      string `json:"end,omitempty"`
+	// Duration of the tests in milliseconds.
+	Duration int `json:"duration"`
+}
+
+type FoxxTestResult struct {
+	Title     string                 `json:"title"`
+	FullTitle string                 `json:"fullTitle"`
+	Duration  int                    `json:"duration"`
+	Err       map[string]interface{} `json:"err,omitempty"`
+}
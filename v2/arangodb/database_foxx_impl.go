@@ -0,0 +1,404 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newDatabaseFoxx(db *database) *databaseFoxx {
+	return &databaseFoxx{
+		db: db,
+	}
+}
+
+var _ DatabaseFoxx = &databaseFoxx{}
+
+type databaseFoxx struct {
+	db *database
+}
+
+func (d databaseFoxx) FoxxServices(ctx context.Context, opts *FoxxListOptions) ([]FoxxServiceListItem, error) {
+	url := d.db.url("_api", "foxx")
+
+	var data byteDecoder
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &data, append(d.db.modifiers, opts.modifyRequest)...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		var services []FoxxServiceListItem
+		if err := data.Unmarshal(&services); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return services, nil
+	default:
+		return nil, data.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseFoxx) FoxxService(ctx context.Context, mount string) (FoxxService, error) {
+	return d.callService(ctx, http.MethodGet, "service", mount, nil)
+}
+
+func (d databaseFoxx) InstallFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxInstallOptions) (FoxxService, error) {
+	url := d.db.url("_api", "foxx")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		FoxxService           `json:",inline"`
+	}
+
+	resp, err := connection.Call(ctx, d.db.connection(), http.MethodPost, url, &response,
+		append(d.db.modifiers, connection.WithQuery("mount", mount), source.modifyRequest, opts.modifyRequest)...)
+	if err != nil {
+		return FoxxService{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated:
+		return response.FoxxService, nil
+	default:
+		return FoxxService{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseFoxx) ReplaceFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxReplaceOptions) (FoxxService, error) {
+	return d.callService(ctx, http.MethodPut, "service", mount, nil, source.modifyRequest, opts.modifyRequest)
+}
+
+func (d databaseFoxx) UpgradeFoxxService(ctx context.Context, mount string, source FoxxServiceSource, opts *FoxxReplaceOptions) (FoxxService, error) {
+	return d.callService(ctx, http.MethodPatch, "service", mount, nil, source.modifyRequest, opts.modifyRequest)
+}
+
+func (d databaseFoxx) UninstallFoxxService(ctx context.Context, mount string, opts *FoxxUninstallOptions) error {
+	url := d.db.url("_api", "foxx", "service")
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), url, nil,
+		append(d.db.modifiers, connection.WithQuery("mount", mount), opts.modifyRequest)...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusNoContent:
+		return nil
+	default:
+		return shared.NewResponseStruct().AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseFoxx) FoxxServiceConfiguration(ctx context.Context, mount string) (map[string]FoxxConfigurationOption, error) {
+	var configuration map[string]FoxxConfigurationOption
+
+	if err := d.callSettings(ctx, http.MethodGet, "configuration", mount, nil, &configuration); err != nil {
+		return nil, err
+	}
+
+	return configuration, nil
+}
+
+func (d databaseFoxx) UpdateFoxxServiceConfiguration(ctx context.Context, mount string, values map[string]interface{}) (map[string]FoxxConfigurationOption, error) {
+	var configuration map[string]FoxxConfigurationOption
+
+	if err := d.callSettings(ctx, http.MethodPatch, "configuration", mount, values, &configuration); err != nil {
+		return nil, err
+	}
+
+	return configuration, nil
+}
+
+func (d databaseFoxx) ReplaceFoxxServiceConfiguration(ctx context.Context, mount string, values map[string]interface{}) (map[string]FoxxConfigurationOption, error) {
+	var configuration map[string]FoxxConfigurationOption
+
+	if err := d.callSettings(ctx, http.MethodPut, "configuration", mount, values, &configuration); err != nil {
+		return nil, err
+	}
+
+	return configuration, nil
+}
+
+func (d databaseFoxx) FoxxServiceDependencies(ctx context.Context, mount string) (map[string]FoxxDependency, error) {
+	var dependencies map[string]FoxxDependency
+
+	if err := d.callSettings(ctx, http.MethodGet, "dependencies", mount, nil, &dependencies); err != nil {
+		return nil, err
+	}
+
+	return dependencies, nil
+}
+
+func (d databaseFoxx) UpdateFoxxServiceDependencies(ctx context.Context, mount string, values map[string]interface{}) (map[string]FoxxDependency, error) {
+	var dependencies map[string]FoxxDependency
+
+	if err := d.callSettings(ctx, http.MethodPatch, "dependencies", mount, values, &dependencies); err != nil {
+		return nil, err
+	}
+
+	return dependencies, nil
+}
+
+func (d databaseFoxx) ReplaceFoxxServiceDependencies(ctx context.Context, mount string, values map[string]interface{}) (map[string]FoxxDependency, error) {
+	var dependencies map[string]FoxxDependency
+
+	if err := d.callSettings(ctx, http.MethodPut, "dependencies", mount, values, &dependencies); err != nil {
+		return nil, err
+	}
+
+	return dependencies, nil
+}
+
+func (d databaseFoxx) EnableFoxxServiceDevelopmentMode(ctx context.Context, mount string) (FoxxService, error) {
+	return d.callService(ctx, http.MethodPost, "development", mount, nil)
+}
+
+func (d databaseFoxx) DisableFoxxServiceDevelopmentMode(ctx context.Context, mount string) (FoxxService, error) {
+	return d.callService(ctx, http.MethodDelete, "development", mount, nil)
+}
+
+func (d databaseFoxx) FoxxServiceScripts(ctx context.Context, mount string) (map[string]string, error) {
+	var scripts map[string]string
+
+	if err := d.callSettings(ctx, http.MethodGet, "scripts", mount, nil, &scripts); err != nil {
+		return nil, err
+	}
+
+	return scripts, nil
+}
+
+func (d databaseFoxx) RunFoxxServiceScript(ctx context.Context, mount, name string, args interface{}, result interface{}) error {
+	url := d.db.url("_api", "foxx", "scripts", name)
+
+	return d.callRaw(ctx, http.MethodPost, url, mount, args, result)
+}
+
+func (d databaseFoxx) RunFoxxServiceTests(ctx context.Context, mount string, opts *FoxxTestOptions, result interface{}) error {
+	url := d.db.url("_api", "foxx", "tests")
+
+	return d.callRaw(ctx, http.MethodPost, url, mount, nil, result, opts.modifyRequest)
+}
+
+func (d databaseFoxx) FoxxServiceReadme(ctx context.Context, mount string) ([]byte, error) {
+	url := d.db.url("_api", "foxx", "readme")
+
+	resp, body, err := connection.CallStream(ctx, d.db.connection(), http.MethodGet, url,
+		append(d.db.modifiers, connection.WithQuery("mount", mount))...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var data []byte
+	if body != nil {
+		defer body.Close()
+
+		if data, err = io.ReadAll(body); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return data, nil
+	case http.StatusNoContent:
+		return nil, nil
+	default:
+		errData := byteDecoder{data: data}
+		return nil, errData.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseFoxx) FoxxServiceSwagger(ctx context.Context, mount string, result interface{}) error {
+	url := d.db.url("_api", "foxx", "swagger")
+
+	return d.callRaw(ctx, http.MethodGet, url, mount, nil, result)
+}
+
+// callService sends the request to the given Foxx endpoint and returns the service metadata from the response.
+func (d databaseFoxx) callService(ctx context.Context, method, endpoint, mount string, body interface{},
+	mods ...connection.RequestModifier) (FoxxService, error) {
+	url := d.db.url("_api", "foxx", endpoint)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		FoxxService           `json:",inline"`
+	}
+
+	modifiers := append(d.db.modifiers, connection.WithQuery("mount", mount), connection.WithBody(body))
+	resp, err := connection.Call(ctx, d.db.connection(), method, url, &response, append(modifiers, mods...)...)
+	if err != nil {
+		return FoxxService{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.FoxxService, nil
+	default:
+		return FoxxService{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+// callSettings sends the request to the given Foxx endpoint and unmarshalls the object from the response into the result.
+func (d databaseFoxx) callSettings(ctx context.Context, method, endpoint, mount string, body interface{}, result interface{}) error {
+	url := d.db.url("_api", "foxx", endpoint)
+
+	return d.callRaw(ctx, method, url, mount, body, result)
+}
+
+// callRaw sends the request to the given URL and unmarshalls the response into the result.
+func (d databaseFoxx) callRaw(ctx context.Context, method, url, mount string, body interface{}, result interface{},
+	mods ...connection.RequestModifier) error {
+	var data byteDecoder
+
+	modifiers := append(d.db.modifiers, connection.WithQuery("mount", mount), connection.WithBody(body))
+	resp, err := connection.Call(ctx, d.db.connection(), method, url, &data, append(modifiers, mods...)...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		if result == nil || len(data.data) == 0 {
+			return nil
+		}
+		return errors.WithStack(data.Unmarshal(result))
+	default:
+		return data.AsArangoErrorWithCode(code)
+	}
+}
+
+func (s FoxxServiceSource) modifyRequest(r connection.Request) error {
+	if s.Bundle != nil && s.URL != "" {
+		return errors.WithStack(shared.InvalidArgumentError{Message: "either bundle or URL of the Foxx service must be set"})
+	}
+
+	if s.Bundle != nil {
+		r.AddHeader(connection.ContentType, connection.ApplicationZip)
+		return r.SetBody(s.Bundle)
+	}
+
+	if s.URL != "" {
+		return r.SetBody(struct {
+			Source string `json:"source"`
+		}{
+			Source: s.URL,
+		})
+	}
+
+	return errors.WithStack(shared.InvalidArgumentError{Message: "source of the Foxx service must be set"})
+}
+
+func (o *FoxxListOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+
+	if o.ExcludeSystem != nil {
+		r.AddQuery("excludeSystem", boolToString(*o.ExcludeSystem))
+	}
+
+	return nil
+}
+
+func (o *FoxxInstallOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+
+	if o.Development != nil {
+		r.AddQuery("development", boolToString(*o.Development))
+	}
+
+	if o.Setup != nil {
+		r.AddQuery("setup", boolToString(*o.Setup))
+	}
+
+	if o.Legacy != nil {
+		r.AddQuery("legacy", boolToString(*o.Legacy))
+	}
+
+	return nil
+}
+
+func (o *FoxxReplaceOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+
+	if o.Teardown != nil {
+		r.AddQuery("teardown", boolToString(*o.Teardown))
+	}
+
+	if o.Setup != nil {
+		r.AddQuery("setup", boolToString(*o.Setup))
+	}
+
+	if o.Legacy != nil {
+		r.AddQuery("legacy", boolToString(*o.Legacy))
+	}
+
+	if o.Force != nil {
+		r.AddQuery("force", boolToString(*o.Force))
+	}
+
+	return nil
+}
+
+func (o *FoxxUninstallOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+
+	if o.Teardown != nil {
+		r.AddQuery("teardown", boolToString(*o.Teardown))
+	}
+
+	return nil
+}
+
+func (o *FoxxTestOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+
+	if o.Reporter != "" {
+		r.AddQuery("reporter", o.Reporter)
+	}
+
+	if o.Idiomatic != nil {
+		r.AddQuery("idiomatic", boolToString(*o.Idiomatic))
+	}
+
+	if o.Filter != "" {
+		r.AddQuery("filter", o.Filter)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// DatabaseGraph provides access to all named graphs in a single database.
+// https://www.arangodb.com/docs/stable/http/gharial-management.html
+type DatabaseGraph interface {
+	// Graph opens a connection to an existing graph within the database.
+	// If no graph with given name exists, an NotFoundError is returned.
+	Graph(ctx context.Context, name string) (Graph, error)
+
+	// GraphExists returns true if a graph with given name exists within the database.
+	GraphExists(ctx context.Context, name string) (bool, error)
+
+	// Graphs returns a list of all graphs in the database.
+	Graphs(ctx context.Context) (GraphsResponseReader, error)
+
+	// CreateGraph creates a new graph with given name and options, and opens a connection to it.
+	// If a graph with given name already exists within the database, a DuplicateError is returned.
+	CreateGraph(ctx context.Context, name string, options *CreateGraphOptions) (Graph, error)
+}
+
+type GraphsResponseReader interface {
+	// Read returns next Graph. If no Graphs left, shared.NoMoreDocumentsError returned
+	Read() (Graph, error)
+}
+
+// CreateGraphOptions contains options that customize the creating of a graph.
+// https://www.arangodb.com/docs/stable/http/gharial-management.html#create-a-graph
+type CreateGraphOptions struct {
+	// EdgeDefinitions is an array of edge definitions for the graph.
+	EdgeDefinitions []EdgeDefinition `json:"edgeDefinitions,omitempty"`
+
+	// OrphanCollections is an array of additional vertex collections used in the graph.
+	// These are vertices for which there are no edges linking these vertices with anything.
+	OrphanCollections []string `json:"orphanCollections,omitempty"`
+
+	// IsSmart defines if the created graph should be smart.
+	// When SmartGraphAttribute is set, a SmartGraph is created.
+	// When SmartGraphAttribute is empty, an EnterpriseGraph is created (ArangoDB 3.10+).
+	// This only has effect in Enterprise Edition.
+	IsSmart bool `json:"isSmart,omitempty"`
+
+	// IsDisjoint creates a Disjoint SmartGraph instead of a regular SmartGraph.
+	// This only has effect in Enterprise Edition.
+	IsDisjoint bool `json:"isDisjoint,omitempty"`
+
+	// Options contains sharding options for all collections created within the graph.
+	Options *CreateGraphShardingOptions `json:"options,omitempty"`
+
+	// WaitForSync defines if the request should wait until everything is synced to disc.
+	WaitForSync *bool `json:"-"`
+}
+
+// CreateGraphShardingOptions contains sharding related options for a new graph.
+// These options are only taken into account in a cluster setup.
+type CreateGraphShardingOptions struct {
+	// SmartGraphAttribute is the attribute name that is used to smartly shard the vertices of a graph.
+	// Every vertex in this Graph has to have this attribute.
+	// Cannot be modified later.
+	SmartGraphAttribute string `json:"smartGraphAttribute,omitempty"`
+
+	// NumberOfShards is the number of shards that is used for every collection within this graph.
+	// Cannot be modified later.
+	NumberOfShards int `json:"numberOfShards,omitempty"`
+
+	// ReplicationFactor is the replication factor that is used for every collection within this graph.
+	// Use ReplicationFactorSatellite to create a SatelliteGraph (Enterprise Edition only).
+	// Cannot be modified later.
+	ReplicationFactor ReplicationFactor `json:"replicationFactor,omitempty"`
+
+	// WriteConcern is the number of in-sync replicas required for every collection within this graph.
+	// Cannot be modified later.
+	WriteConcern int `json:"writeConcern,omitempty"`
+
+	// Satellites contains an array of collection names that will be used to create SatelliteCollections
+	// for a Hybrid (Disjoint) SmartGraph (Enterprise Edition only).
+	// Requires ArangoDB 3.9+
+	Satellites []string `json:"satellites,omitempty"`
+}
+
+func (c *CreateGraphOptions) modifyRequest(r connection.Request) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.WaitForSync != nil {
+		r.AddQuery("waitForSync", boolToString(*c.WaitForSync))
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newDatabaseGraph(db *database) *databaseGraph {
+	return &databaseGraph{
+		db: db,
+	}
+}
+
+var _ DatabaseGraph = &databaseGraph{}
+
+type databaseGraph struct {
+	db *database
+}
+
+func (d databaseGraph) Graph(ctx context.Context, name string) (Graph, error) {
+	url := d.db.url("_api", "gharial", name)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Graph                 GraphDefinition `json:"graph"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return newGraph(d.db, response.Graph), nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseGraph) GraphExists(ctx context.Context, name string) (bool, error) {
+	_, err := d.Graph(ctx, name)
+	if err == nil {
+		return true, nil
+	}
+
+	if shared.IsNotFound(err) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func (d databaseGraph) Graphs(ctx context.Context) (GraphsResponseReader, error) {
+	url := d.db.url("_api", "gharial")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Graphs                connection.Array `json:"graphs,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return newGraphsResponseReader(d.db, &response.Graphs), nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseGraph) CreateGraph(ctx context.Context, name string, options *CreateGraphOptions) (Graph, error) {
+	url := d.db.url("_api", "gharial")
+
+	input := struct {
+		Name string `json:"name"`
+		*CreateGraphOptions
+	}{
+		Name:               name,
+		CreateGraphOptions: options,
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Graph                 GraphDefinition `json:"graph"`
+	}
+
+	resp, err := connection.CallPost(ctx, d.db.connection(), url, &response, input, append(d.db.modifiers, options.modifyRequest)...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated, http.StatusAccepted:
+		return newGraph(d.db, response.Graph), nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func newGraphsResponseReader(db *database, arr *connection.Array) GraphsResponseReader {
+	return &graphsResponseReader{
+		array: arr,
+		db:    db,
+	}
+}
+
+type graphsResponseReader struct {
+	array *connection.Array
+	db    *database
+}
+
+func (reader *graphsResponseReader) Read() (Graph, error) {
+	if !reader.array.More() {
+		return nil, shared.NoMoreDocumentsError{}
+	}
+
+	var definition GraphDefinition
+
+	if err := reader.array.Unmarshal(newUnmarshalInto(&definition)); err != nil {
+		if err == io.EOF {
+			return nil, shared.NoMoreDocumentsError{}
+		}
+		return nil, err
+	}
+
+	return newGraph(reader.db, definition), nil
+}
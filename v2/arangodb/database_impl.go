@@ -38,6 +38,11 @@ func newDatabase(c *client, name string, modifiers ...connection.RequestModifier
 	d.databaseQuery = newDatabaseQuery(d)
 	d.databaseView = newDatabaseView(d)
 	d.databaseAnalyzer = newDatabaseAnalyzer(d)
+	d.databaseGraph = newDatabaseGraph(d)
+	d.databasePregel = newDatabasePregel(d)
+	d.databaseFoxx = newDatabaseFoxx(d)
+	d.databaseAQLFunction = newDatabaseAQLFunction(d)
+	d.databaseTask = newDatabaseTask(d)
 
 	return d
 }
@@ -54,6 +59,11 @@ type database struct {
 	*databaseQuery
 	*databaseView
 	*databaseAnalyzer
+	*databaseGraph
+	*databasePregel
+	*databaseFoxx
+	*databaseAQLFunction
+	*databaseTask
 }
 
 func (d database) Remove(ctx context.Context) error {
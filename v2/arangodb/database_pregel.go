@@ -0,0 +1,354 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"time"
+)
+
+// DatabasePregel provides access to all Pregel Jobs in a single database.
+// https://www.arangodb.com/docs/stable/http/pregel.html
+type DatabasePregel interface {
+	// StartJob starts the execution of a Pregel algorithm and returns the ID of the job.
+	StartJob(ctx context.Context, options PregelJobOptions) (string, error)
+
+	// GetJob returns the status of a Pregel execution.
+	GetJob(ctx context.Context, id string) (PregelJob, error)
+
+	// GetJobs returns a list of currently running and recently finished Pregel jobs without retrieving their results.
+	GetJobs(ctx context.Context) ([]PregelJob, error)
+
+	// CancelJob cancels an ongoing Pregel execution.
+	CancelJob(ctx context.Context, id string) error
+
+	// WaitForJob polls the status of a Pregel execution until it reaches a terminal state
+	// (done, canceled or fatal error) and returns the last status of the job.
+	// An error is returned if the job has been canceled or failed.
+	WaitForJob(ctx context.Context, id string, opts *PregelWaitOptions) (PregelJob, error)
+}
+
+type PregelAlgorithm string
+
+const (
+	PregelAlgorithmPageRank                        PregelAlgorithm = "pagerank"
+	PregelAlgorithmSingleSourceShortestPath        PregelAlgorithm = "sssp"
+	PregelAlgorithmConnectedComponents             PregelAlgorithm = "connectedcomponents"
+	PregelAlgorithmWeaklyConnectedComponents       PregelAlgorithm = "wcc"
+	PregelAlgorithmStronglyConnectedComponents     PregelAlgorithm = "scc"
+	PregelAlgorithmHyperlinkInducedTopicSearch     PregelAlgorithm = "hits"
+	PregelAlgorithmEffectiveCloseness              PregelAlgorithm = "effectivecloseness"
+	PregelAlgorithmLineRank                        PregelAlgorithm = "linerank"
+	PregelAlgorithmLabelPropagation                PregelAlgorithm = "labelpropagation"
+	PregelAlgorithmSpeakerListenerLabelPropagation PregelAlgorithm = "slpa"
+)
+
+type PregelJobOptions struct {
+	// Name of the algorithm.
+	// It can be omitted when Params is one of the typed algorithm parameters (e.g. PregelPageRankParams).
+	Algorithm PregelAlgorithm `json:"algorithm"`
+	// Name of a graph. Either this or the parameters VertexCollections and EdgeCollections are required.
+	// Please note that there are special sharding requirements for graphs in order to be used with Pregel.
+	GraphName string `json:"graphName,omitempty"`
+	// List of vertex collection names.
+	// Please note that there are special sharding requirements for collections in order to be used with Pregel.
+	VertexCollections []string `json:"vertexCollections,omitempty"`
+	// List of edge collection names.
+	// Please note that there are special sharding requirements for collections in order to be used with Pregel.
+	EdgeCollections []string `json:"edgeCollections,omitempty"`
+	// General as well as algorithm-specific options.
+	// It can be one of the typed algorithm parameters (PregelAlgorithmParams) or a map.
+	Params interface{} `json:"params,omitempty"`
+}
+
+// PregelAlgorithmParams is implemented by the typed parameters of each Pregel algorithm.
+type PregelAlgorithmParams interface {
+	// Algorithm returns the algorithm which the parameters belong to.
+	Algorithm() PregelAlgorithm
+}
+
+// PregelCommonParams contains parameters supported by all Pregel algorithms.
+type PregelCommonParams struct {
+	// Store the results back into the vertex documents. Defaults to true.
+	Store *bool `json:"store,omitempty"`
+	// Maximum number of global iterations for this algorithm.
+	MaxGSS int `json:"maxGSS,omitempty"`
+	// Number of parallel threads to use per worker.
+	Parallelism int `json:"parallelism,omitempty"`
+	// Algorithms which support asynchronous mode run without synchronized global iterations.
+	Async *bool `json:"async,omitempty"`
+	// The attribute of the vertex documents to write the result to.
+	ResultField string `json:"resultField,omitempty"`
+	// Use memory mapped files to store temporary data.
+	UseMemoryMaps *bool `json:"useMemoryMaps,omitempty"`
+	// The sharding key attribute of the collections. Defaults to `vertex`.
+	ShardKeyAttribute string `json:"shardKeyAttribute,omitempty"`
+}
+
+// PregelPageRankParams contains parameters of the PageRank algorithm.
+type PregelPageRankParams struct {
+	PregelCommonParams
+
+	// Execute until the value changes in the vertices are at most the threshold.
+	Threshold float64 `json:"threshold,omitempty"`
+	// The attribute of the vertex documents with initial values (Seeded PageRank).
+	SourceField string `json:"sourceField,omitempty"`
+}
+
+func (p PregelPageRankParams) Algorithm() PregelAlgorithm {
+	return PregelAlgorithmPageRank
+}
+
+// PregelSingleSourceShortestPathParams contains parameters of the Single-Source Shortest Path algorithm.
+type PregelSingleSourceShortestPathParams struct {
+	PregelCommonParams
+
+	// The vertex ID to calculate distances from.
+	Source string `json:"source"`
+}
+
+func (p PregelSingleSourceShortestPathParams) Algorithm() PregelAlgorithm {
+	return PregelAlgorithmSingleSourceShortestPath
+}
+
+// PregelConnectedComponentsParams contains parameters of the Connected Components algorithm.
+type PregelConnectedComponentsParams struct {
+	PregelCommonParams
+}
+
+func (p PregelConnectedComponentsParams) Algorithm() PregelAlgorithm {
+	return PregelAlgorithmConnectedComponents
+}
+
+// PregelWeaklyConnectedComponentsParams contains parameters of the Weakly Connected Components algorithm.
+type PregelWeaklyConnectedComponentsParams struct {
+	PregelCommonParams
+}
+
+func (p PregelWeaklyConnectedComponentsParams) Algorithm() PregelAlgorithm {
+	return PregelAlgorithmWeaklyConnectedComponents
+}
+
+// PregelStronglyConnectedComponentsParams contains parameters of the Strongly Connected Components algorithm.
+type PregelStronglyConnectedComponentsParams struct {
+	PregelCommonParams
+}
+
+func (p PregelStronglyConnectedComponentsParams) Algorithm() PregelAlgorithm {
+	return PregelAlgorithmStronglyConnectedComponents
+}
+
+// PregelHyperlinkInducedTopicSearchParams contains parameters of the HITS algorithm.
+type PregelHyperlinkInducedTopicSearchParams struct {
+	PregelCommonParams
+
+	// Execute until the value changes in the vertices are at most the threshold.
+	Threshold float64 `json:"threshold,omitempty"`
+}
+
+func (p PregelHyperlinkInducedTopicSearchParams) Algorithm() PregelAlgorithm {
+	return PregelAlgorithmHyperlinkInducedTopicSearch
+}
+
+// PregelEffectiveClosenessParams contains parameters of the Effective Closeness algorithm.
+type PregelEffectiveClosenessParams struct {
+	PregelCommonParams
+}
+
+func (p PregelEffectiveClosenessParams) Algorithm() PregelAlgorithm {
+	return PregelAlgorithmEffectiveCloseness
+}
+
+// PregelLineRankParams contains parameters of the LineRank algorithm.
+type PregelLineRankParams struct {
+	PregelCommonParams
+}
+
+func (p PregelLineRankParams) Algorithm() PregelAlgorithm {
+	return PregelAlgorithmLineRank
+}
+
+// PregelLabelPropagationParams contains parameters of the Label Propagation algorithm.
+type PregelLabelPropagationParams struct {
+	PregelCommonParams
+}
+
+func (p PregelLabelPropagationParams) Algorithm() PregelAlgorithm {
+	return PregelAlgorithmLabelPropagation
+}
+
+// PregelSpeakerListenerLabelPropagationParams contains parameters of the Speaker-Listener Label Propagation algorithm.
+type PregelSpeakerListenerLabelPropagationParams struct {
+	PregelCommonParams
+
+	// Maximum number of communities a vertex can be member of.
+	MaxCommunities int `json:"maxCommunities,omitempty"`
+}
+
+func (p PregelSpeakerListenerLabelPropagationParams) Algorithm() PregelAlgorithm {
+	return PregelAlgorithmSpeakerListenerLabelPropagation
+}
+
+type PregelJobState string
+
+const (
+	// PregelJobStateNone - The Pregel run did not yet start.
+	PregelJobStateNone PregelJobState = "none"
+	// PregelJobStateLoading - The graph is loaded from the database into memory before the execution of the algorithm.
+	PregelJobStateLoading PregelJobState = "loading"
+	// PregelJobStateRunning - The algorithm is executing normally.
+	PregelJobStateRunning PregelJobState = "running"
+	// PregelJobStateStoring - The algorithm finished, but the results are still being written back into the collections.
+	// Occurs only if the store parameter is set to true.
+	PregelJobStateStoring PregelJobState = "storing"
+	// PregelJobStateDone - The execution is done.
+	PregelJobStateDone PregelJobState = "done"
+	// PregelJobStateCanceled - The execution was permanently canceled, either by the user or by an error.
+	PregelJobStateCanceled PregelJobState = "canceled"
+	// PregelJobStateFatalError - The execution has failed and cannot recover.
+	PregelJobStateFatalError PregelJobState = "fatal error"
+	// PregelJobStateInError - The execution is in an error state.
+	// This can be caused by DB-Servers being not reachable or being non-responsive.
+	// The execution might recover later, or switch to "canceled" if it was not able to recover successfully.
+	PregelJobStateInError PregelJobState = "in error"
+	// PregelJobStateRecovering - The execution is actively recovering and switches back to running if the recovery is successful.
+	PregelJobStateRecovering PregelJobState = "recovering"
+)
+
+// IsTerminal returns true if the Pregel execution will not change its state anymore.
+func (s PregelJobState) IsTerminal() bool {
+	switch s {
+	case PregelJobStateDone, PregelJobStateCanceled, PregelJobStateFatalError:
+		return true
+	default:
+		return false
+	}
+}
+
+type PregelJob struct {
+	// The ID of the Pregel job, as a string.
+	ID string `json:"id"`
+	// The algorithm used by the job.
+	Algorithm PregelAlgorithm `json:"algorithm,omitempty"`
+	// The date and time when the job was created.
+	Created time.Time `json:"created,omitempty"`
+	// The date and time when the job results expire.
+	// The expiration date is only meaningful for jobs that were completed, canceled or resulted in an error.
+	Expires *time.Time `json:"expires,omitempty"`
+	// The TTL (time to live) value for the job results, specified in seconds.
+	TTL uint64 `json:"ttl,omitempty"`
+	// The state of the execution.
+	State PregelJobState `json:"state,omitempty"`
+	// The number of global supersteps executed.
+	Gss uint64 `json:"gss,omitempty"`
+	// The total runtime of the execution up to now (if the execution is still ongoing).
+	TotalRuntime float64 `json:"totalRuntime,omitempty"`
+	// The startup runtime of the execution. The startup time includes the data loading time and can be substantial.
+	StartupTime float64 `json:"startupTime,omitempty"`
+	// The algorithm execution time. Is shown when the computation started.
+	ComputationTime float64 `json:"computationTime,omitempty"`
+	// The time for storing the results if the job includes results storage. Is shown when the storing started.
+	StorageTime float64 `json:"storageTime,omitempty"`
+	// Computation time of each global super step. Is shown when the computation started.
+	GSSTimes []float64 `json:"gssTimes,omitempty"`
+	// This attribute is used by Programmable Pregel Algorithms (air, experimental).
+	// The value is only populated once the algorithm has finished.
+	Reports []map[string]interface{} `json:"reports,omitempty"`
+	// The total number of vertices processed.
+	VertexCount uint64 `json:"vertexCount,omitempty"`
+	// The total number of edges processed.
+	EdgeCount uint64 `json:"edgeCount,omitempty"`
+	// UseMemoryMaps
+	UseMemoryMaps *bool `json:"useMemoryMaps,omitempty"`
+	// The Pregel run details.
+	// Available from 3.10 arangod version.
+	Detail *PregelRunDetails `json:"detail,omitempty"`
+}
+
+// PregelRunDetails - The Pregel run details.
+// Available from 3.10 arangod version.
+type PregelRunDetails struct {
+	// The aggregated details of the full Pregel run. The values are totals of all the DB-Server.
+	AggregatedStatus *AggregatedStatus `json:"aggregatedStatus,omitempty"`
+	// The details of the Pregel for every DB-Server.
+	// Each object key is a DB-Server ID, and each value is a nested object similar to the aggregatedStatus attribute.
+	// In a single server deployment, there is only a single entry with an empty string as key.
+	WorkerStatus map[string]*AggregatedStatus `json:"workerStatus,omitempty"`
+}
+
+// AggregatedStatus The aggregated details of the full Pregel run. The values are totals of all the DB-Server.
+type AggregatedStatus struct {
+	// The time at which the status was measured.
+	TimeStamp time.Time `json:"timeStamp,omitempty"`
+	// The status of the in memory graph.
+	GraphStoreStatus *GraphStoreStatus `json:"graphStoreStatus,omitempty"`
+	// Information about the global supersteps.
+	AllGSSStatus *AllGSSStatus `json:"allGssStatus,omitempty"`
+}
+
+// GraphStoreStatus The status of the in memory graph.
+type GraphStoreStatus struct {
+	// The number of vertices that are loaded from the database into memory.
+	VerticesLoaded uint64 `json:"verticesLoaded,omitempty"`
+	// The number of edges that are loaded from the database into memory.
+	EdgesLoaded uint64 `json:"edgesLoaded,omitempty"`
+	// The number of bytes used in-memory for the loaded graph.
+	MemoryBytesUsed uint64 `json:"memoryBytesUsed,omitempty"`
+	// The number of vertices that are written back to the database after the Pregel computation finished.
+	// It is only set if the store parameter is set to true.
+	VerticesStored uint64 `json:"verticesStored,omitempty"`
+}
+
+// AllGSSStatus Information about the global supersteps.
+type AllGSSStatus struct {
+	// A list of objects with details for each global superstep.
+	Items []GSSStatus `json:"items,omitempty"`
+}
+
+// GSSStatus Information about the global superstep
+type GSSStatus struct {
+	// The number of vertices that have been processed in this step.
+	VerticesProcessed uint64 `json:"verticesProcessed,omitempty"`
+	// The number of messages sent in this step.
+	MessagesSent uint64 `json:"messagesSent,omitempty"`
+	// The number of messages received in this step.
+	MessagesReceived uint64 `json:"messagesReceived,omitempty"`
+	// The number of bytes used in memory for the messages in this step.
+	MemoryBytesUsedForMessages uint64 `json:"memoryBytesUsedForMessages,omitempty"`
+}
+
+// PregelWaitOptions provides options for DatabasePregel.WaitForJob
+type PregelWaitOptions struct {
+	// PollInterval is the time between two status requests. Defaults to 1 second.
+	PollInterval time.Duration
+
+	// OnProgress is called with every status of the job received from the server.
+	// It can be used to report the number of executed global supersteps and timings.
+	OnProgress func(job PregelJob)
+}
+
+func (o *PregelWaitOptions) pollInterval() time.Duration {
+	if o == nil || o.PollInterval <= 0 {
+		return time.Second
+	}
+
+	return o.PollInterval
+}
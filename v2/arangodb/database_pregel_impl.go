@@ -0,0 +1,199 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newDatabasePregel(db *database) *databasePregel {
+	return &databasePregel{
+		db: db,
+	}
+}
+
+var _ DatabasePregel = &databasePregel{}
+
+type databasePregel struct {
+	db *database
+}
+
+func (d databasePregel) StartJob(ctx context.Context, options PregelJobOptions) (string, error) {
+	url := d.db.url("_api", "control_pregel")
+
+	if params, ok := options.Params.(PregelAlgorithmParams); ok {
+		// The params are implemented with value receivers, so a nil pointer can not provide its algorithm.
+		if isNilPregelParams(params) {
+			return "", errors.WithStack(shared.InvalidArgumentError{Message: "params of the Pregel job must not be a nil pointer"})
+		}
+
+		if options.Algorithm == "" {
+			options.Algorithm = params.Algorithm()
+		} else if options.Algorithm != params.Algorithm() {
+			return "", errors.WithStack(shared.InvalidArgumentError{
+				Message: "algorithm " + string(options.Algorithm) + " does not match params of " + string(params.Algorithm()),
+			})
+		}
+	}
+
+	var data byteDecoder
+
+	resp, err := connection.CallPost(ctx, d.db.connection(), url, &data, &options, d.db.modifiers...)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		var id string
+		if err := data.Unmarshal(&id); err != nil {
+			return "", errors.WithStack(err)
+		}
+		return id, nil
+	default:
+		return "", data.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databasePregel) GetJob(ctx context.Context, id string) (PregelJob, error) {
+	url := d.db.url("_api", "control_pregel", id)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		PregelJob             `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return PregelJob{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.PregelJob, nil
+	default:
+		return PregelJob{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databasePregel) GetJobs(ctx context.Context) ([]PregelJob, error) {
+	url := d.db.url("_api", "control_pregel")
+
+	var data byteDecoder
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &data, d.db.modifiers...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		var jobs []PregelJob
+		if err := data.Unmarshal(&jobs); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return jobs, nil
+	default:
+		return nil, data.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databasePregel) CancelJob(ctx context.Context, id string) error {
+	url := d.db.url("_api", "control_pregel", id)
+
+	var data byteDecoder
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), url, &data, d.db.modifiers...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return data.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databasePregel) WaitForJob(ctx context.Context, id string, opts *PregelWaitOptions) (PregelJob, error) {
+	ticker := time.NewTicker(opts.pollInterval())
+	defer ticker.Stop()
+
+	for {
+		job, err := d.GetJob(ctx, id)
+		if err != nil {
+			return PregelJob{}, err
+		}
+
+		if opts != nil && opts.OnProgress != nil {
+			opts.OnProgress(job)
+		}
+
+		switch job.State {
+		case PregelJobStateDone:
+			return job, nil
+		case PregelJobStateCanceled, PregelJobStateFatalError:
+			return job, errors.Errorf("pregel job %s finished with state '%s'", id, job.State)
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// isNilPregelParams returns true when the params are a nil pointer to one of the params of the algorithms.
+func isNilPregelParams(params PregelAlgorithmParams) bool {
+	switch p := params.(type) {
+	case *PregelPageRankParams:
+		return p == nil
+	case *PregelSingleSourceShortestPathParams:
+		return p == nil
+	case *PregelConnectedComponentsParams:
+		return p == nil
+	case *PregelWeaklyConnectedComponentsParams:
+		return p == nil
+	case *PregelStronglyConnectedComponentsParams:
+		return p == nil
+	case *PregelHyperlinkInducedTopicSearchParams:
+		return p == nil
+	case *PregelEffectiveClosenessParams:
+		return p == nil
+	case *PregelLineRankParams:
+		return p == nil
+	case *PregelLabelPropagationParams:
+		return p == nil
+	case *PregelSpeakerListenerLabelPropagationParams:
+		return p == nil
+	default:
+		return false
+	}
+}
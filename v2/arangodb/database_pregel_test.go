@@ -0,0 +1,95 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func TestPregelJobOptions_MarshalTypedParams(t *testing.T) {
+	store := false
+
+	tests := map[string]struct {
+		params PregelAlgorithmParams
+		want   string
+	}{
+		"pagerank": {
+			params: PregelPageRankParams{
+				PregelCommonParams: PregelCommonParams{Store: &store, ResultField: "rank"},
+				Threshold:          0.0001,
+			},
+			want: `{"store":false,"resultField":"rank","threshold":0.0001}`,
+		},
+		"sssp": {
+			params: PregelSingleSourceShortestPathParams{Source: "vertices/1"},
+			want:   `{"source":"vertices/1"}`,
+		},
+		"slpa": {
+			params: PregelSpeakerListenerLabelPropagationParams{
+				PregelCommonParams: PregelCommonParams{MaxGSS: 10},
+				MaxCommunities:     3,
+			},
+			want: `{"maxGSS":10,"maxCommunities":3}`,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			data, err := json.Marshal(test.params)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.want, string(data))
+		})
+	}
+}
+
+func TestDatabasePregel_StartJobNilParams(t *testing.T) {
+	db := newDatabase(newTestClient("http://localhost"), "db")
+
+	var params *PregelPageRankParams
+	_, err := db.StartJob(context.Background(), PregelJobOptions{GraphName: "graph", Params: params})
+	require.Error(t, err)
+	assert.True(t, shared.IsInvalidArgument(err))
+}
+
+func TestPregelJobState_IsTerminal(t *testing.T) {
+	terminal := map[PregelJobState]bool{
+		PregelJobStateNone:       false,
+		PregelJobStateLoading:    false,
+		PregelJobStateRunning:    false,
+		PregelJobStateStoring:    false,
+		PregelJobStateInError:    false,
+		PregelJobStateRecovering: false,
+		PregelJobStateDone:       true,
+		PregelJobStateCanceled:   true,
+		PregelJobStateFatalError: true,
+	}
+
+	for state, want := range terminal {
+		assert.Equal(t, want, state.IsTerminal(), string(state))
+	}
+}
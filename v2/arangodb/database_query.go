@@ -20,7 +20,12 @@
 
 package arangodb
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
 
 type DatabaseQuery interface {
 	// Query performs an AQL query, returning a cursor used to iterate over the returned documents.
@@ -39,6 +44,40 @@ type DatabaseQuery interface {
 
 	// ExplainQuery explains an AQL query and return information about it.
 	ExplainQuery(ctx context.Context, query string, bindVars map[string]interface{}, opts *ExplainQueryOptions) (ExplainQueryResult, error)
+
+	// RunningQueries returns the AQL queries which are currently running.
+	// https://www.arangodb.com/docs/stable/http/aql-query.html#returns-the-currently-running-aql-queries
+	RunningQueries(ctx context.Context, opts *QueryTrackingOptions) ([]RunningAQLQuery, error)
+
+	// SlowQueries returns the AQL queries which have exceeded the slow query threshold.
+	// https://www.arangodb.com/docs/stable/http/aql-query.html#returns-the-list-of-slow-aql-queries
+	SlowQueries(ctx context.Context, opts *QueryTrackingOptions) ([]RunningAQLQuery, error)
+
+	// ClearSlowQueries clears the list of slow AQL queries.
+	ClearSlowQueries(ctx context.Context, opts *QueryTrackingOptions) error
+
+	// KillQuery kills the running AQL query with the given ID.
+	// The query is aborted at the next cancellation point.
+	KillQuery(ctx context.Context, queryID string, opts *QueryTrackingOptions) error
+
+	// QueryProperties returns the configuration of the AQL query tracking.
+	QueryProperties(ctx context.Context) (QueryProperties, error)
+
+	// SetQueryProperties changes the configuration of the AQL query tracking and returns the new configuration.
+	SetQueryProperties(ctx context.Context, options SetQueryPropertiesOptions) (QueryProperties, error)
+
+	// QueryCacheProperties returns the configuration of the AQL query results cache.
+	// https://www.arangodb.com/docs/stable/http/aql-query-cache.html
+	QueryCacheProperties(ctx context.Context) (QueryCacheProperties, error)
+
+	// SetQueryCacheProperties changes the configuration of the AQL query results cache and returns the new configuration.
+	SetQueryCacheProperties(ctx context.Context, options SetQueryCachePropertiesOptions) (QueryCacheProperties, error)
+
+	// QueryCacheEntries returns the entries of the AQL query results cache for the database.
+	QueryCacheEntries(ctx context.Context) ([]QueryCacheEntry, error)
+
+	// ClearQueryCache removes all entries of the AQL query results cache for the database.
+	ClearQueryCache(ctx context.Context) error
 }
 
 type QuerySubOptions struct {
@@ -105,6 +144,15 @@ type QueryOptions struct {
 	// key/value pairs representing the bind parameters.
 	BindVars map[string]interface{} `json:"bindVars,omitempty"`
 	Options  QuerySubOptions        `json:"options,omitempty"`
+
+	// BatchRetryAttempts is the number of times the cursor fetches a batch again, when reading it fails with a network error.
+	// The batches are fetched again from the coordinator which owns the cursor.
+	// It is used only when QuerySubOptions.AllowRetry is set, because only then the server keeps the last batch.
+	// The default is 3, a negative value disables the automatic retries.
+	BatchRetryAttempts int `json:"-"`
+	// BatchRetryDelay is the delay before the first retry of a batch, it is doubled for each next retry.
+	// The default is 100ms.
+	BatchRetryDelay time.Duration `json:"-"`
 }
 
 // QuerySubOptionsOptimizer describes optimization's settings for AQL queries.
@@ -189,3 +237,133 @@ type ExplainQueryResult struct {
 	// This attribute is not present when allPlans is set to true.
 	Cacheable *bool `json:"cacheable,omitempty"`
 }
+
+type QueryTrackingOptions struct {
+	// All if set to true, uses the queries of all databases, not only the ones of the current database.
+	// It can be used only in the _system database and requires superuser rights.
+	All *bool
+}
+
+func (q *QueryTrackingOptions) modifyRequest(r connection.Request) error {
+	if q == nil {
+		return nil
+	}
+
+	if q.All != nil {
+		r.AddQuery("all", boolToString(*q.All))
+	}
+
+	return nil
+}
+
+// RunningAQLQuery describes an AQL query which is running or has been slow.
+type RunningAQLQuery struct {
+	// ID of the query.
+	ID string `json:"id"`
+	// Database is the name of the database the query runs in.
+	Database string `json:"database"`
+	// User is the name of the user who started the query.
+	User string `json:"user"`
+	// Query is the query string, possibly truncated to the maximum query string length.
+	Query string `json:"query"`
+	// BindVars holds the bind parameters of the query, if the tracking of bind parameters is enabled.
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+	// Started is the date and time when the query was started.
+	Started time.Time `json:"started"`
+	// RunTime is the run time of the query in seconds.
+	RunTime float64 `json:"runTime"`
+	// PeakMemoryUsage is the peak memory usage of the query in bytes.
+	PeakMemoryUsage uint64 `json:"peakMemoryUsage,omitempty"`
+	// State is the state of the query, e.g. "executing" or "finished" for slow queries.
+	State string `json:"state"`
+	// Stream is true when the query is a streaming query.
+	Stream bool `json:"stream"`
+}
+
+// QueryProperties holds the configuration of the AQL query tracking.
+type QueryProperties struct {
+	// Enabled is true when the currently running and slow queries are tracked.
+	Enabled bool `json:"enabled"`
+	// TrackSlowQueries is true when the slow queries are tracked.
+	TrackSlowQueries bool `json:"trackSlowQueries"`
+	// TrackBindVars is true when the bind parameters of the queries are tracked.
+	TrackBindVars bool `json:"trackBindVars"`
+	// MaxSlowQueries is the maximum number of slow queries to keep in the list.
+	MaxSlowQueries int `json:"maxSlowQueries"`
+	// SlowQueryThreshold is the threshold in seconds above which a query is considered slow.
+	SlowQueryThreshold float64 `json:"slowQueryThreshold"`
+	// SlowStreamingQueryThreshold is the threshold in seconds above which a streaming query is considered slow.
+	SlowStreamingQueryThreshold float64 `json:"slowStreamingQueryThreshold"`
+	// MaxQueryStringLength is the maximum length of query strings which are stored.
+	MaxQueryStringLength int `json:"maxQueryStringLength"`
+}
+
+// SetQueryPropertiesOptions holds the changes of the AQL query tracking configuration.
+// The properties which are not set remain unchanged.
+type SetQueryPropertiesOptions struct {
+	Enabled                     *bool    `json:"enabled,omitempty"`
+	TrackSlowQueries            *bool    `json:"trackSlowQueries,omitempty"`
+	TrackBindVars               *bool    `json:"trackBindVars,omitempty"`
+	MaxSlowQueries              *int     `json:"maxSlowQueries,omitempty"`
+	SlowQueryThreshold          *float64 `json:"slowQueryThreshold,omitempty"`
+	SlowStreamingQueryThreshold *float64 `json:"slowStreamingQueryThreshold,omitempty"`
+	MaxQueryStringLength        *int     `json:"maxQueryStringLength,omitempty"`
+}
+
+// QueryCacheMode is the mode of the AQL query results cache.
+type QueryCacheMode string
+
+const (
+	// QueryCacheModeOff - the cache is not used.
+	QueryCacheModeOff QueryCacheMode = "off"
+	// QueryCacheModeOn - the results of all cacheable queries are cached.
+	QueryCacheModeOn QueryCacheMode = "on"
+	// QueryCacheModeDemand - only the results of queries run with QueryOptions.Cache true are cached.
+	QueryCacheModeDemand QueryCacheMode = "demand"
+)
+
+// QueryCacheProperties holds the configuration of the AQL query results cache.
+type QueryCacheProperties struct {
+	// Mode is the mode of the cache.
+	Mode QueryCacheMode `json:"mode"`
+	// MaxResults is the maximum number of query results stored per database-specific cache.
+	MaxResults uint64 `json:"maxResults"`
+	// MaxResultsSize is the maximum cumulated size of query results in bytes stored per database-specific cache.
+	MaxResultsSize uint64 `json:"maxResultsSize"`
+	// MaxEntrySize is the maximum size of a single query result in bytes.
+	MaxEntrySize uint64 `json:"maxEntrySize"`
+	// IncludeSystem is true when the results of queries which use system collections are cached.
+	IncludeSystem bool `json:"includeSystem"`
+}
+
+// SetQueryCachePropertiesOptions holds the changes of the AQL query results cache configuration.
+// The properties which are not set remain unchanged.
+type SetQueryCachePropertiesOptions struct {
+	Mode           *QueryCacheMode `json:"mode,omitempty"`
+	MaxResults     *uint64         `json:"maxResults,omitempty"`
+	MaxResultsSize *uint64         `json:"maxResultsSize,omitempty"`
+	MaxEntrySize   *uint64         `json:"maxEntrySize,omitempty"`
+	IncludeSystem  *bool           `json:"includeSystem,omitempty"`
+}
+
+// QueryCacheEntry describes a query result stored in the AQL query results cache.
+type QueryCacheEntry struct {
+	// Hash is the hash of the query result.
+	Hash string `json:"hash"`
+	// Query is the query string.
+	Query string `json:"query"`
+	// BindVars holds the bind parameters of the query, if the tracking of bind parameters is enabled.
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+	// Size is the size of the query result and the bind parameters in bytes.
+	Size uint64 `json:"size"`
+	// Results is the number of documents or rows in the query result.
+	Results uint64 `json:"results"`
+	// Started is the date and time when the query was stored in the cache.
+	Started time.Time `json:"started"`
+	// Hits is the number of times the result was served from the cache.
+	Hits uint64 `json:"hits"`
+	// RunTime is the run time of the query in seconds.
+	RunTime float64 `json:"runTime"`
+	// DataSources holds the names of the collections and views used by the query.
+	DataSources []string `json:"dataSources,omitempty"`
+}
@@ -27,6 +27,8 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/pkg/errors"
+
 	"github.com/arangodb/go-driver/v2/arangodb/shared"
 
 	"github.com/arangodb/go-driver/v2/connection"
@@ -76,7 +78,7 @@ func (d databaseQuery) getCursor(ctx context.Context, query string, opts *QueryO
 				return nil, err
 			}
 		}
-		return newCursor(d.db, resp.Endpoint(), response.cursorData), nil
+		return newCursor(d.db, resp.Endpoint(), response.cursorData, opts), nil
 	default:
 		return nil, response.AsArangoErrorWithCode(code)
 	}
@@ -136,3 +138,187 @@ func (d databaseQuery) ExplainQuery(ctx context.Context, query string, bindVars
 		return ExplainQueryResult{}, response.AsArangoErrorWithCode(code)
 	}
 }
+
+func (d databaseQuery) RunningQueries(ctx context.Context, opts *QueryTrackingOptions) ([]RunningAQLQuery, error) {
+	return d.listQueries(ctx, "current", opts)
+}
+
+func (d databaseQuery) SlowQueries(ctx context.Context, opts *QueryTrackingOptions) ([]RunningAQLQuery, error) {
+	return d.listQueries(ctx, "slow", opts)
+}
+
+func (d databaseQuery) listQueries(ctx context.Context, list string, opts *QueryTrackingOptions) ([]RunningAQLQuery, error) {
+	url := d.db.url("_api", "query", list)
+
+	// The response is an array, but an error is returned as an object.
+	var response byteDecoder
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, append(d.db.modifiers, opts.modifyRequest)...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		var queries []RunningAQLQuery
+		if err := response.Unmarshal(&queries); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return queries, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) ClearSlowQueries(ctx context.Context, opts *QueryTrackingOptions) error {
+	url := d.db.url("_api", "query", "slow")
+
+	return d.deleteQuery(ctx, url, opts)
+}
+
+func (d databaseQuery) KillQuery(ctx context.Context, queryID string, opts *QueryTrackingOptions) error {
+	url := d.db.url("_api", "query", queryID)
+
+	return d.deleteQuery(ctx, url, opts)
+}
+
+func (d databaseQuery) deleteQuery(ctx context.Context, url string, opts *QueryTrackingOptions) error {
+	var response shared.ResponseStruct
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), url, &response, append(d.db.modifiers, opts.modifyRequest)...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) QueryProperties(ctx context.Context) (QueryProperties, error) {
+	url := d.db.url("_api", "query", "properties")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		QueryProperties       `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return QueryProperties{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryProperties, nil
+	default:
+		return QueryProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) SetQueryProperties(ctx context.Context, options SetQueryPropertiesOptions) (QueryProperties, error) {
+	url := d.db.url("_api", "query", "properties")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		QueryProperties       `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, d.db.connection(), url, &response, options, d.db.modifiers...)
+	if err != nil {
+		return QueryProperties{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryProperties, nil
+	default:
+		return QueryProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) QueryCacheProperties(ctx context.Context) (QueryCacheProperties, error) {
+	url := d.db.url("_api", "query-cache", "properties")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		QueryCacheProperties  `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return QueryCacheProperties{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryCacheProperties, nil
+	default:
+		return QueryCacheProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) SetQueryCacheProperties(ctx context.Context, options SetQueryCachePropertiesOptions) (QueryCacheProperties, error) {
+	url := d.db.url("_api", "query-cache", "properties")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		QueryCacheProperties  `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, d.db.connection(), url, &response, options, d.db.modifiers...)
+	if err != nil {
+		return QueryCacheProperties{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.QueryCacheProperties, nil
+	default:
+		return QueryCacheProperties{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) QueryCacheEntries(ctx context.Context) ([]QueryCacheEntry, error) {
+	url := d.db.url("_api", "query-cache", "entries")
+
+	// The response is an array, but an error is returned as an object.
+	var response byteDecoder
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		var entries []QueryCacheEntry
+		if err := response.Unmarshal(&entries); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return entries, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseQuery) ClearQueryCache(ctx context.Context) error {
+	url := d.db.url("_api", "query-cache")
+
+	var response shared.ResponseStruct
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
@@ -0,0 +1,100 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// DatabaseTask manages the JavaScript tasks of a database, which are executed by the server once or periodically.
+// https://www.arangodb.com/docs/stable/http/tasks.html
+type DatabaseTask interface {
+	// Tasks returns all tasks of the server.
+	Tasks(ctx context.Context) ([]TaskInfo, error)
+
+	// Task returns the task with the given ID.
+	// If the task does not exist, a NotFoundError is returned.
+	Task(ctx context.Context, id string) (TaskInfo, error)
+
+	// CreateTask registers a new task. When options.ID is empty, the ID is generated by the server.
+	// If a task with the given ID already exists, an ArangoError with errorNum 1851 is returned.
+	CreateTask(ctx context.Context, options TaskOptions) (TaskInfo, error)
+
+	// RemoveTask removes the task with the given ID.
+	// If the task does not exist, a NotFoundError is returned.
+	RemoveTask(ctx context.Context, id string) error
+}
+
+// TaskType is the type of task.
+type TaskType string
+
+const (
+	// TaskTypePeriodic is a task which is executed repeatedly.
+	TaskTypePeriodic TaskType = "periodic"
+	// TaskTypeTimed is a task which is executed once.
+	TaskTypeTimed TaskType = "timed"
+)
+
+// TaskOptions describes a new task.
+type TaskOptions struct {
+	// ID of the task. When it is empty, the ID is generated by the server.
+	ID string `json:"-"`
+	// Name of the task.
+	Name string `json:"name"`
+	// Command is the JavaScript code of the task, e.g. "(function (params) { require('console').log(params); })(params)".
+	// The params are available in the code as the variable params.
+	Command string `json:"command"`
+	// Params are passed to the command.
+	Params interface{} `json:"params,omitempty"`
+	// Period is the number of seconds between the executions of a periodic task.
+	// When it is not set, the task is executed only once.
+	Period float64 `json:"period,omitempty"`
+	// Offset is the number of seconds after which the task is executed for the first time.
+	Offset float64 `json:"offset,omitempty"`
+}
+
+// TaskInfo describes a registered task.
+type TaskInfo struct {
+	// ID of the task.
+	ID string `json:"id"`
+	// Name of the task.
+	Name string `json:"name"`
+	// Type of the task.
+	Type TaskType `json:"type"`
+	// Created is the time when the task was created, in seconds since the epoch.
+	Created float64 `json:"created"`
+	// Period is the number of seconds between the executions of a periodic task.
+	Period float64 `json:"period,omitempty"`
+	// Offset is the number of seconds after which the task is executed for the first time.
+	Offset float64 `json:"offset,omitempty"`
+	// Command is the JavaScript code of the task.
+	Command string `json:"command"`
+	// Database is the name of the database in which the task is executed.
+	Database string `json:"database"`
+}
+
+// CreatedAt returns the time when the task was created.
+func (t TaskInfo) CreatedAt() time.Time {
+	seconds, fraction := math.Modf(t.Created)
+	return time.Unix(int64(seconds), int64(fraction*float64(time.Second)))
+}
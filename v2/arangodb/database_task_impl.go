@@ -0,0 +1,132 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newDatabaseTask(db *database) *databaseTask {
+	return &databaseTask{
+		db: db,
+	}
+}
+
+var _ DatabaseTask = &databaseTask{}
+
+type databaseTask struct {
+	db *database
+}
+
+func (d databaseTask) Tasks(ctx context.Context) ([]TaskInfo, error) {
+	url := d.db.url("_api", "tasks")
+
+	// The response is an array, but an error is returned as an object.
+	var response byteDecoder
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		var tasks []TaskInfo
+		if err := response.Unmarshal(&tasks); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return tasks, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseTask) Task(ctx context.Context, id string) (TaskInfo, error) {
+	url := d.db.url("_api", "tasks", id)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		TaskInfo              `json:",inline"`
+	}
+
+	resp, err := connection.CallGet(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return TaskInfo{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.TaskInfo, nil
+	default:
+		return TaskInfo{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseTask) CreateTask(ctx context.Context, options TaskOptions) (TaskInfo, error) {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		TaskInfo              `json:",inline"`
+	}
+
+	var resp connection.Response
+	var err error
+	if options.ID == "" {
+		url := d.db.url("_api", "tasks")
+		resp, err = connection.CallPost(ctx, d.db.connection(), url, &response, options, d.db.modifiers...)
+	} else {
+		url := d.db.url("_api", "tasks", options.ID)
+		resp, err = connection.CallPut(ctx, d.db.connection(), url, &response, options, d.db.modifiers...)
+	}
+	if err != nil {
+		return TaskInfo{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.TaskInfo, nil
+	default:
+		return TaskInfo{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (d databaseTask) RemoveTask(ctx context.Context, id string) error {
+	url := d.db.url("_api", "tasks", id)
+
+	var response shared.ResponseStruct
+
+	resp, err := connection.CallDelete(ctx, d.db.connection(), url, &response, d.db.modifiers...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
@@ -0,0 +1,134 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// Graph provides access to all edge & vertex collections of a single graph in a database.
+type Graph interface {
+	// Name returns the name of the graph.
+	Name() string
+
+	// Database returns the database containing the graph.
+	Database() Database
+
+	// Definition returns the graph definition fetched from the server when the graph was opened.
+	Definition() GraphDefinition
+
+	// IsSmart returns true if the graph is a SmartGraph or an EnterpriseGraph.
+	// In case of Community Edition it is always false.
+	IsSmart() bool
+
+	// IsSatellite returns true if the graph is a SatelliteGraph.
+	// In case of Community Edition it is always false.
+	IsSatellite() bool
+
+	// IsDisjoint returns true if the graph is a Disjoint SmartGraph.
+	IsDisjoint() bool
+
+	// EdgeDefinitions returns the edge definitions of the graph.
+	EdgeDefinitions() []EdgeDefinition
+
+	// OrphanCollections returns the orphan collections of the graph.
+	OrphanCollections() []string
+
+	// Remove removes the entire graph.
+	// If the graph does not exist, a NotFoundError is returned.
+	Remove(ctx context.Context, opts *RemoveGraphOptions) error
+
+	GraphVertexCollections
+	GraphEdgeDefinitions
+}
+
+// GraphDefinition describes a named graph as returned by the server.
+type GraphDefinition struct {
+	Name string `json:"name"`
+	ID   string `json:"_id,omitempty"`
+	Key  string `json:"_key,omitempty"`
+	Rev  string `json:"_rev,omitempty"`
+
+	// EdgeDefinitions is an array of edge definitions of the graph.
+	EdgeDefinitions []EdgeDefinition `json:"edgeDefinitions,omitempty"`
+	// OrphanCollections is an array of additional vertex collections used in the graph.
+	OrphanCollections []string `json:"orphanCollections,omitempty"`
+
+	// IsSmart is true for SmartGraphs and EnterpriseGraphs.
+	IsSmart bool `json:"isSmart,omitempty"`
+	// IsSatellite is true for SatelliteGraphs.
+	IsSatellite bool `json:"isSatellite,omitempty"`
+	// IsDisjoint is true for Disjoint SmartGraphs.
+	IsDisjoint bool `json:"isDisjoint,omitempty"`
+
+	// NumberOfShards is the number of shards of every collection within this graph.
+	NumberOfShards int `json:"numberOfShards,omitempty"`
+	// ReplicationFactor is the replication factor of every collection within this graph.
+	ReplicationFactor ReplicationFactor `json:"replicationFactor,omitempty"`
+	// WriteConcern is the number of in-sync replicas required for every collection within this graph.
+	WriteConcern int `json:"writeConcern,omitempty"`
+	// SmartGraphAttribute is the attribute used for sharding vertices of a SmartGraph.
+	SmartGraphAttribute string `json:"smartGraphAttribute,omitempty"`
+}
+
+// EdgeDefinition contains all information needed to define a single edge in a graph.
+type EdgeDefinition struct {
+	// Collection is the name of the edge collection to be used.
+	Collection string `json:"collection"`
+	// To contains the names of one or more vertex collections that can contain target vertices.
+	To []string `json:"to"`
+	// From contains the names of one or more vertex collections that can contain source vertices.
+	From []string `json:"from"`
+	// Options contains optional parameters
+	Options *EdgeDefinitionOptions `json:"options,omitempty"`
+}
+
+// EdgeDefinitionOptions contains optional parameters of an edge definition.
+type EdgeDefinitionOptions struct {
+	// Satellites contains an array of collection names that will be used to create SatelliteCollections
+	// for a Hybrid (Disjoint) SmartGraph (Enterprise Edition only).
+	// Requires ArangoDB 3.9+
+	Satellites []string `json:"satellites,omitempty"`
+}
+
+type RemoveGraphOptions struct {
+	// DropCollections when set to true drops all collections of the graph as well.
+	// Collections are only dropped if they are not used in other graphs.
+	DropCollections *bool
+
+	// WaitForSync defines if the request should wait until everything is synced to disc.
+	WaitForSync *bool
+}
+
+func (o *RemoveGraphOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+	if o.DropCollections != nil {
+		r.AddQuery("dropCollections", boolToString(*o.DropCollections))
+	}
+	if o.WaitForSync != nil {
+		r.AddQuery("waitForSync", boolToString(*o.WaitForSync))
+	}
+	return nil
+}
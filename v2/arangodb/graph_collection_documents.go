@@ -0,0 +1,265 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// The responses and the options of the document operations are the same for vertices and edges of a named graph.
+// They are aliased by the Vertex* and the Edge* types.
+
+type GraphCollectionDocumentCreateResponse struct {
+	DocumentMeta
+	shared.ResponseStruct `json:",inline"`
+	New                   interface{}
+}
+
+type GraphCollectionDocumentUpdateResponse struct {
+	DocumentMeta
+	shared.ResponseStruct `json:",inline"`
+	Old, New              interface{}
+}
+
+type GraphCollectionDocumentReplaceResponse struct {
+	DocumentMeta
+	shared.ResponseStruct `json:",inline"`
+	Old, New              interface{}
+}
+
+type GraphCollectionDocumentDeleteResponse struct {
+	shared.ResponseStruct `json:",inline"`
+	Old                   interface{}
+}
+
+type GraphCollectionDocumentReadOptions struct {
+	// If the “If-Match” header is given, then it must contain exactly one ETag (_rev).
+	// The document is returned, if it has the same revision as the given ETag.
+	IfMatch string
+
+	// If the “If-None-Match” header is given, then it must contain exactly one ETag (_rev).
+	// The document is returned, if it has a different revision than the given ETag.
+	IfNoneMatch string
+
+	// To make this operation a part of a Stream Transaction, set this header to the transaction ID returned by the
+	// DatabaseTransaction.BeginTransaction() method.
+	TransactionID string
+}
+
+func (c *GraphCollectionDocumentReadOptions) modifyRequest(r connection.Request) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.IfMatch != "" {
+		r.AddHeader("If-Match", c.IfMatch)
+	}
+
+	if c.IfNoneMatch != "" {
+		r.AddHeader("If-None-Match", c.IfNoneMatch)
+	}
+
+	if c.TransactionID != "" {
+		r.AddHeader("x-arango-trx-id", c.TransactionID)
+	}
+
+	return nil
+}
+
+type GraphCollectionDocumentCreateOptions struct {
+	// Wait until document has been synced to disk.
+	WithWaitForSync *bool
+
+	// Additionally return the complete new document
+	NewObject interface{}
+
+	// To make this operation a part of a Stream Transaction, set this header to the transaction ID returned by the
+	// DatabaseTransaction.BeginTransaction() method.
+	TransactionID string
+}
+
+func (c *GraphCollectionDocumentCreateOptions) modifyRequest(r connection.Request) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.WithWaitForSync != nil {
+		r.AddQuery("waitForSync", boolToString(*c.WithWaitForSync))
+	}
+
+	if c.NewObject != nil {
+		r.AddQuery("returnNew", "true")
+	}
+
+	if c.TransactionID != "" {
+		r.AddHeader("x-arango-trx-id", c.TransactionID)
+	}
+
+	return nil
+}
+
+type GraphCollectionDocumentUpdateOptions struct {
+	// Conditionally update a document based on a target revision id
+	IfMatch string
+
+	// Wait until document has been synced to disk.
+	WithWaitForSync *bool
+
+	// If the intention is to delete existing attributes with the patch command, set it to false.
+	// This modifies the behavior of the patch command to remove any attributes from the existing document
+	// that are contained in the patch document with an attribute value of null.
+	KeepNull *bool
+
+	// Additionally return the complete new document
+	NewObject interface{}
+
+	// Additionally return the complete old document
+	OldObject interface{}
+
+	// To make this operation a part of a Stream Transaction, set this header to the transaction ID returned by the
+	// DatabaseTransaction.BeginTransaction() method.
+	TransactionID string
+}
+
+func (c *GraphCollectionDocumentUpdateOptions) modifyRequest(r connection.Request) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.IfMatch != "" {
+		r.AddHeader("If-Match", c.IfMatch)
+	}
+
+	if c.WithWaitForSync != nil {
+		r.AddQuery("waitForSync", boolToString(*c.WithWaitForSync))
+	}
+
+	if c.KeepNull != nil {
+		r.AddQuery("keepNull", boolToString(*c.KeepNull))
+	}
+
+	if c.NewObject != nil {
+		r.AddQuery("returnNew", "true")
+	}
+
+	if c.OldObject != nil {
+		r.AddQuery("returnOld", "true")
+	}
+
+	if c.TransactionID != "" {
+		r.AddHeader("x-arango-trx-id", c.TransactionID)
+	}
+
+	return nil
+}
+
+type GraphCollectionDocumentReplaceOptions struct {
+	// Conditionally replace a document based on a target revision id
+	IfMatch string
+
+	// Wait until document has been synced to disk.
+	WithWaitForSync *bool
+
+	// If the intention is to delete existing attributes with the replace command, set it to false.
+	KeepNull *bool
+
+	// Additionally return the complete new document
+	NewObject interface{}
+
+	// Additionally return the complete old document
+	OldObject interface{}
+
+	// To make this operation a part of a Stream Transaction, set this header to the transaction ID returned by the
+	// DatabaseTransaction.BeginTransaction() method.
+	TransactionID string
+}
+
+func (c *GraphCollectionDocumentReplaceOptions) modifyRequest(r connection.Request) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.IfMatch != "" {
+		r.AddHeader("If-Match", c.IfMatch)
+	}
+
+	if c.WithWaitForSync != nil {
+		r.AddQuery("waitForSync", boolToString(*c.WithWaitForSync))
+	}
+
+	if c.KeepNull != nil {
+		r.AddQuery("keepNull", boolToString(*c.KeepNull))
+	}
+
+	if c.NewObject != nil {
+		r.AddQuery("returnNew", "true")
+	}
+
+	if c.OldObject != nil {
+		r.AddQuery("returnOld", "true")
+	}
+
+	if c.TransactionID != "" {
+		r.AddHeader("x-arango-trx-id", c.TransactionID)
+	}
+
+	return nil
+}
+
+type GraphCollectionDocumentDeleteOptions struct {
+	// Conditionally delete a document based on a target revision id
+	IfMatch string
+
+	// Wait until the deletion operation has been synced to disk.
+	WithWaitForSync *bool
+
+	// Return additionally the complete previous revision of the changed document
+	OldObject interface{}
+
+	// To make this operation a part of a Stream Transaction, set this header to the transaction ID returned by the
+	// DatabaseTransaction.BeginTransaction() method.
+	TransactionID string
+}
+
+func (c *GraphCollectionDocumentDeleteOptions) modifyRequest(r connection.Request) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.IfMatch != "" {
+		r.AddHeader("If-Match", c.IfMatch)
+	}
+
+	if c.WithWaitForSync != nil {
+		r.AddQuery("waitForSync", boolToString(*c.WithWaitForSync))
+	}
+
+	if c.OldObject != nil {
+		r.AddQuery("returnOld", "true")
+	}
+
+	if c.TransactionID != "" {
+		r.AddHeader("x-arango-trx-id", c.TransactionID)
+	}
+
+	return nil
+}
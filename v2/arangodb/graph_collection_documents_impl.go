@@ -0,0 +1,202 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// graphCollectionDocuments implements single document operations shared by vertex and edge collections.
+// All requests go through `_api/gharial/<graph>/<kind>/<collection>`, so the server validates edge definitions
+// and removes dangling edges when vertices are deleted.
+type graphCollectionDocuments struct {
+	graph      *graph
+	kind       string
+	collection string
+}
+
+// graphDocumentResponse is a response returned by the gharial document endpoints.
+// Depending on the kind of the collection, the document metadata is returned under `vertex` or `edge` key.
+type graphDocumentResponse struct {
+	shared.ResponseStruct `json:",inline"`
+	Vertex                *DocumentMeta  `json:"vertex,omitempty"`
+	Edge                  *DocumentMeta  `json:"edge,omitempty"`
+	Old                   *UnmarshalInto `json:"old,omitempty"`
+	New                   *UnmarshalInto `json:"new,omitempty"`
+}
+
+func newGraphDocumentResponse(meta *DocumentMeta, oldObject, newObject interface{}) *graphDocumentResponse {
+	return &graphDocumentResponse{
+		Vertex: meta,
+		Edge:   meta,
+		Old:    newUnmarshalInto(oldObject),
+		New:    newUnmarshalInto(newObject),
+	}
+}
+
+func (g graphCollectionDocuments) read(ctx context.Context, key string, result interface{}, opts *GraphCollectionDocumentReadOptions) (DocumentMeta, error) {
+	if err := validateKey(key); err != nil {
+		return DocumentMeta{}, err
+	}
+
+	var meta DocumentMeta
+
+	data := &multiUnmarshaller{obj: []interface{}{&meta, newUnmarshalInto(result)}}
+
+	response := struct {
+		shared.ResponseStruct `json:",inline"`
+		Vertex                *multiUnmarshaller `json:"vertex,omitempty"`
+		Edge                  *multiUnmarshaller `json:"edge,omitempty"`
+	}{
+		Vertex: data,
+		Edge:   data,
+	}
+
+	resp, err := connection.CallGet(ctx, g.graph.db.connection(), g.url(key), &response, g.graph.withModifiers(opts.modifyRequest)...)
+	if err != nil {
+		return DocumentMeta{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return meta, nil
+	default:
+		return DocumentMeta{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (g graphCollectionDocuments) create(ctx context.Context, document interface{}, opts *GraphCollectionDocumentCreateOptions) (GraphCollectionDocumentCreateResponse, error) {
+	var meta GraphCollectionDocumentCreateResponse
+
+	if opts != nil {
+		meta.New = opts.NewObject
+	}
+
+	response := newGraphDocumentResponse(&meta.DocumentMeta, nil, meta.New)
+
+	resp, err := connection.CallPost(ctx, g.graph.db.connection(), g.url(), response, document, g.graph.withModifiers(opts.modifyRequest)...)
+	if err != nil {
+		return GraphCollectionDocumentCreateResponse{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated, http.StatusAccepted:
+		meta.ResponseStruct = response.ResponseStruct
+		return meta, nil
+	default:
+		return GraphCollectionDocumentCreateResponse{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (g graphCollectionDocuments) update(ctx context.Context, key string, document interface{}, opts *GraphCollectionDocumentUpdateOptions) (GraphCollectionDocumentUpdateResponse, error) {
+	var meta GraphCollectionDocumentUpdateResponse
+
+	if opts != nil {
+		meta.Old = opts.OldObject
+		meta.New = opts.NewObject
+	}
+
+	response, err := g.modify(ctx, http.MethodPatch, key, document, &meta.DocumentMeta, meta.Old, meta.New, opts.modifyRequest)
+	if err != nil {
+		return GraphCollectionDocumentUpdateResponse{}, err
+	}
+
+	meta.ResponseStruct = response
+
+	return meta, nil
+}
+
+func (g graphCollectionDocuments) replace(ctx context.Context, key string, document interface{}, opts *GraphCollectionDocumentReplaceOptions) (GraphCollectionDocumentReplaceResponse, error) {
+	var meta GraphCollectionDocumentReplaceResponse
+
+	if opts != nil {
+		meta.Old = opts.OldObject
+		meta.New = opts.NewObject
+	}
+
+	response, err := g.modify(ctx, http.MethodPut, key, document, &meta.DocumentMeta, meta.Old, meta.New, opts.modifyRequest)
+	if err != nil {
+		return GraphCollectionDocumentReplaceResponse{}, err
+	}
+
+	meta.ResponseStruct = response
+
+	return meta, nil
+}
+
+// modify updates or replaces the document, depending on the method.
+func (g graphCollectionDocuments) modify(ctx context.Context, method, key string, document interface{}, meta *DocumentMeta, oldObject, newObject interface{}, modifiers ...connection.RequestModifier) (shared.ResponseStruct, error) {
+	if err := validateKey(key); err != nil {
+		return shared.ResponseStruct{}, err
+	}
+
+	response := newGraphDocumentResponse(meta, oldObject, newObject)
+
+	resp, err := connection.Call(ctx, g.graph.db.connection(), method, g.url(key), response, g.graph.withModifiers(append(modifiers, connection.WithBody(document))...)...)
+	if err != nil {
+		return shared.ResponseStruct{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusAccepted:
+		return response.ResponseStruct, nil
+	default:
+		return shared.ResponseStruct{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (g graphCollectionDocuments) delete(ctx context.Context, key string, opts *GraphCollectionDocumentDeleteOptions) (GraphCollectionDocumentDeleteResponse, error) {
+	if err := validateKey(key); err != nil {
+		return GraphCollectionDocumentDeleteResponse{}, err
+	}
+
+	var meta GraphCollectionDocumentDeleteResponse
+
+	if opts != nil {
+		meta.Old = opts.OldObject
+	}
+
+	response := newGraphDocumentResponse(nil, meta.Old, nil)
+
+	resp, err := connection.CallDelete(ctx, g.graph.db.connection(), g.url(key), response, g.graph.withModifiers(opts.modifyRequest)...)
+	if err != nil {
+		return GraphCollectionDocumentDeleteResponse{}, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusAccepted:
+		meta.ResponseStruct = response.ResponseStruct
+		return meta, nil
+	default:
+		return GraphCollectionDocumentDeleteResponse{}, response.AsArangoErrorWithCode(code)
+	}
+}
+
+// url returns the path to the collection documents (`_db/<db-name>/_api/gharial/<graph-name>/<kind>/<collection>/<key>`)
+func (g graphCollectionDocuments) url(key ...string) string {
+	return g.graph.url(append([]string{g.kind, g.collection}, key...)...)
+}
@@ -0,0 +1,86 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+// EdgeCollectionDocuments contains methods for edge documents of a collection accessed through a named graph.
+// https://www.arangodb.com/docs/stable/http/gharial-edges.html
+type EdgeCollectionDocuments interface {
+	// ReadEdge reads a single edge with given key from the collection.
+	// The document data is stored into result, the document metadata is returned.
+	// If no edge exists with given key, a NotFoundError is returned.
+	ReadEdge(ctx context.Context, key string, result interface{}) (DocumentMeta, error)
+
+	// ReadEdgeWithOptions reads a single edge with given key from the collection.
+	// The document data is stored into result, the document metadata is returned.
+	// If no edge exists with given key, a NotFoundError is returned.
+	ReadEdgeWithOptions(ctx context.Context, key string, result interface{}, opts *EdgeReadOptions) (DocumentMeta, error)
+
+	// CreateEdge creates a single edge in the collection.
+	// If the document data already contains a `_key` field, this will be used as key of the new document,
+	// otherwise a unique key is created.
+	CreateEdge(ctx context.Context, edge interface{}) (EdgeCreateResponse, error)
+
+	// CreateEdgeWithOptions creates a single edge in the collection.
+	// If the document data already contains a `_key` field, this will be used as key of the new document,
+	// otherwise a unique key is created.
+	CreateEdgeWithOptions(ctx context.Context, edge interface{}, opts *EdgeCreateOptions) (EdgeCreateResponse, error)
+
+	// UpdateEdge partially updates a single edge with given key in the collection.
+	// If no edge exists with given key, a NotFoundError is returned.
+	UpdateEdge(ctx context.Context, key string, edge interface{}) (EdgeUpdateResponse, error)
+
+	// UpdateEdgeWithOptions partially updates a single edge with given key in the collection.
+	// If no edge exists with given key, a NotFoundError is returned.
+	UpdateEdgeWithOptions(ctx context.Context, key string, edge interface{}, opts *EdgeUpdateOptions) (EdgeUpdateResponse, error)
+
+	// ReplaceEdge replaces a single edge with given key in the collection.
+	// If no edge exists with given key, a NotFoundError is returned.
+	ReplaceEdge(ctx context.Context, key string, edge interface{}) (EdgeReplaceResponse, error)
+
+	// ReplaceEdgeWithOptions replaces a single edge with given key in the collection.
+	// If no edge exists with given key, a NotFoundError is returned.
+	ReplaceEdgeWithOptions(ctx context.Context, key string, edge interface{}, opts *EdgeReplaceOptions) (EdgeReplaceResponse, error)
+
+	// DeleteEdge removes a single edge with given key from the collection.
+	// If no edge exists with given key, a NotFoundError is returned.
+	DeleteEdge(ctx context.Context, key string) (EdgeDeleteResponse, error)
+
+	// DeleteEdgeWithOptions removes a single edge with given key from the collection.
+	// If no edge exists with given key, a NotFoundError is returned.
+	DeleteEdgeWithOptions(ctx context.Context, key string, opts *EdgeDeleteOptions) (EdgeDeleteResponse, error)
+}
+
+type (
+	EdgeCreateResponse  = GraphCollectionDocumentCreateResponse
+	EdgeUpdateResponse  = GraphCollectionDocumentUpdateResponse
+	EdgeReplaceResponse = GraphCollectionDocumentReplaceResponse
+	EdgeDeleteResponse  = GraphCollectionDocumentDeleteResponse
+
+	EdgeReadOptions    = GraphCollectionDocumentReadOptions
+	EdgeCreateOptions  = GraphCollectionDocumentCreateOptions
+	EdgeUpdateOptions  = GraphCollectionDocumentUpdateOptions
+	EdgeReplaceOptions = GraphCollectionDocumentReplaceOptions
+	EdgeDeleteOptions  = GraphCollectionDocumentDeleteOptions
+)
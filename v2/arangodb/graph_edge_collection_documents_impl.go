@@ -0,0 +1,83 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+func newEdgeCollectionDocuments(collection *edgeCollection) *edgeCollectionDocuments {
+	return &edgeCollectionDocuments{
+		collection: collection,
+		documents: graphCollectionDocuments{
+			graph:      collection.graph,
+			kind:       "edge",
+			collection: collection.name,
+		},
+	}
+}
+
+var _ EdgeCollectionDocuments = &edgeCollectionDocuments{}
+
+type edgeCollectionDocuments struct {
+	collection *edgeCollection
+	documents  graphCollectionDocuments
+}
+
+func (c edgeCollectionDocuments) ReadEdge(ctx context.Context, key string, result interface{}) (DocumentMeta, error) {
+	return c.documents.read(ctx, key, result, nil)
+}
+
+func (c edgeCollectionDocuments) ReadEdgeWithOptions(ctx context.Context, key string, result interface{}, opts *EdgeReadOptions) (DocumentMeta, error) {
+	return c.documents.read(ctx, key, result, opts)
+}
+
+func (c edgeCollectionDocuments) CreateEdge(ctx context.Context, edge interface{}) (EdgeCreateResponse, error) {
+	return c.documents.create(ctx, edge, nil)
+}
+
+func (c edgeCollectionDocuments) CreateEdgeWithOptions(ctx context.Context, edge interface{}, opts *EdgeCreateOptions) (EdgeCreateResponse, error) {
+	return c.documents.create(ctx, edge, opts)
+}
+
+func (c edgeCollectionDocuments) UpdateEdge(ctx context.Context, key string, edge interface{}) (EdgeUpdateResponse, error) {
+	return c.documents.update(ctx, key, edge, nil)
+}
+
+func (c edgeCollectionDocuments) UpdateEdgeWithOptions(ctx context.Context, key string, edge interface{}, opts *EdgeUpdateOptions) (EdgeUpdateResponse, error) {
+	return c.documents.update(ctx, key, edge, opts)
+}
+
+func (c edgeCollectionDocuments) ReplaceEdge(ctx context.Context, key string, edge interface{}) (EdgeReplaceResponse, error) {
+	return c.documents.replace(ctx, key, edge, nil)
+}
+
+func (c edgeCollectionDocuments) ReplaceEdgeWithOptions(ctx context.Context, key string, edge interface{}, opts *EdgeReplaceOptions) (EdgeReplaceResponse, error) {
+	return c.documents.replace(ctx, key, edge, opts)
+}
+
+func (c edgeCollectionDocuments) DeleteEdge(ctx context.Context, key string) (EdgeDeleteResponse, error) {
+	return c.documents.delete(ctx, key, nil)
+}
+
+func (c edgeCollectionDocuments) DeleteEdgeWithOptions(ctx context.Context, key string, opts *EdgeDeleteOptions) (EdgeDeleteResponse, error) {
+	return c.documents.delete(ctx, key, opts)
+}
@@ -0,0 +1,132 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// GraphEdgeDefinitions provides access to all edge definitions of a single graph in a database.
+// https://www.arangodb.com/docs/stable/http/gharial-management.html#list-edge-collections
+type GraphEdgeDefinitions interface {
+	// EdgeCollection opens a connection to an existing edge-collection within the graph.
+	// If no edge-collection with given name exists, an NotFoundError is returned.
+	EdgeCollection(ctx context.Context, name string) (EdgeCollection, error)
+
+	// EdgeCollectionExists returns true if an edge-collection with given name exists within the graph.
+	EdgeCollectionExists(ctx context.Context, name string) (bool, error)
+
+	// EdgeCollections returns all edge collections of this graph.
+	EdgeCollections(ctx context.Context) ([]EdgeCollection, error)
+
+	// CreateEdgeDefinition adds an edge definition to the graph.
+	// If the edge collection or any of the vertex collections do not exist yet, they are created.
+	CreateEdgeDefinition(ctx context.Context, collection string, from, to []string, opts *CreateEdgeDefinitionOptions) (EdgeCollection, error)
+
+	// ReplaceEdgeDefinition changes the vertex constraints of an existing edge definition in the graph.
+	ReplaceEdgeDefinition(ctx context.Context, collection string, from, to []string, opts *ReplaceEdgeDefinitionOptions) error
+
+	// DeleteEdgeDefinition removes an edge definition from the graph.
+	// The edge collection is kept in the database unless DropCollections is set.
+	DeleteEdgeDefinition(ctx context.Context, collection string, opts *DeleteEdgeDefinitionOptions) error
+}
+
+// EdgeCollection is an edge collection accessed through a named graph.
+type EdgeCollection interface {
+	// Name returns the name of the collection.
+	Name() string
+
+	// Graph returns the graph containing the collection.
+	Graph() Graph
+
+	EdgeCollectionDocuments
+}
+
+type CreateEdgeDefinitionOptions struct {
+	// Satellites contains an array of collection names that will be used to create SatelliteCollections
+	// for a Hybrid (Disjoint) SmartGraph (Enterprise Edition only).
+	// Requires ArangoDB 3.9+
+	Satellites []string
+
+	// WaitForSync defines if the request should wait until everything is synced to disc.
+	WaitForSync *bool
+}
+
+func (o *CreateEdgeDefinitionOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+	if o.WaitForSync != nil {
+		r.AddQuery("waitForSync", boolToString(*o.WaitForSync))
+	}
+	return nil
+}
+
+type ReplaceEdgeDefinitionOptions struct {
+	// Satellites contains an array of collection names that will be used to create SatelliteCollections
+	// for a Hybrid (Disjoint) SmartGraph (Enterprise Edition only).
+	// Requires ArangoDB 3.9+
+	Satellites []string
+
+	// DropCollections when set to true drops collections which are no longer used in the graph.
+	// Collections are only dropped if they are not used in other graphs.
+	DropCollections *bool
+
+	// WaitForSync defines if the request should wait until everything is synced to disc.
+	WaitForSync *bool
+}
+
+func (o *ReplaceEdgeDefinitionOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+	if o.DropCollections != nil {
+		r.AddQuery("dropCollections", boolToString(*o.DropCollections))
+	}
+	if o.WaitForSync != nil {
+		r.AddQuery("waitForSync", boolToString(*o.WaitForSync))
+	}
+	return nil
+}
+
+type DeleteEdgeDefinitionOptions struct {
+	// DropCollections when set to true drops the edge collection as well.
+	// The collection is only dropped if it is not used in other graphs.
+	DropCollections *bool
+
+	// WaitForSync defines if the request should wait until everything is synced to disc.
+	WaitForSync *bool
+}
+
+func (o *DeleteEdgeDefinitionOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+	if o.DropCollections != nil {
+		r.AddQuery("dropCollections", boolToString(*o.DropCollections))
+	}
+	if o.WaitForSync != nil {
+		r.AddQuery("waitForSync", boolToString(*o.WaitForSync))
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newGraphEdgeDefinitions(g *graph) *graphEdgeDefinitions {
+	return &graphEdgeDefinitions{
+		graph: g,
+	}
+}
+
+var _ GraphEdgeDefinitions = &graphEdgeDefinitions{}
+
+type graphEdgeDefinitions struct {
+	graph *graph
+}
+
+func (g *graphEdgeDefinitions) EdgeCollection(ctx context.Context, name string) (EdgeCollection, error) {
+	names, err := g.edgeCollectionNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, n := range names {
+		if n == name {
+			return newEdgeCollection(g.graph, name), nil
+		}
+	}
+
+	return nil, shared.NewResponseStruct().AsArangoErrorWithCode(http.StatusNotFound)
+}
+
+func (g *graphEdgeDefinitions) EdgeCollectionExists(ctx context.Context, name string) (bool, error) {
+	_, err := g.EdgeCollection(ctx, name)
+	if err == nil {
+		return true, nil
+	}
+
+	if shared.IsNotFound(err) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func (g *graphEdgeDefinitions) EdgeCollections(ctx context.Context) ([]EdgeCollection, error) {
+	names, err := g.edgeCollectionNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]EdgeCollection, len(names))
+	for i, n := range names {
+		result[i] = newEdgeCollection(g.graph, n)
+	}
+
+	return result, nil
+}
+
+func (g *graphEdgeDefinitions) CreateEdgeDefinition(ctx context.Context, collection string, from, to []string, opts *CreateEdgeDefinitionOptions) (EdgeCollection, error) {
+	url := g.graph.url("edge")
+
+	input := EdgeDefinition{
+		Collection: collection,
+		From:       from,
+		To:         to,
+	}
+	if opts != nil && len(opts.Satellites) > 0 {
+		input.Options = &EdgeDefinitionOptions{Satellites: opts.Satellites}
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallPost(ctx, g.graph.db.connection(), url, &response, input, g.graph.withModifiers(opts.modifyRequest)...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated, http.StatusAccepted:
+		return newEdgeCollection(g.graph, collection), nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (g *graphEdgeDefinitions) ReplaceEdgeDefinition(ctx context.Context, collection string, from, to []string, opts *ReplaceEdgeDefinitionOptions) error {
+	url := g.graph.url("edge", collection)
+
+	input := EdgeDefinition{
+		Collection: collection,
+		From:       from,
+		To:         to,
+	}
+	if opts != nil && len(opts.Satellites) > 0 {
+		input.Options = &EdgeDefinitionOptions{Satellites: opts.Satellites}
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, g.graph.db.connection(), url, &response, input, g.graph.withModifiers(opts.modifyRequest)...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated, http.StatusAccepted:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (g *graphEdgeDefinitions) DeleteEdgeDefinition(ctx context.Context, collection string, opts *DeleteEdgeDefinitionOptions) error {
+	url := g.graph.url("edge", collection)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, g.graph.db.connection(), url, &response, g.graph.withModifiers(opts.modifyRequest)...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated, http.StatusAccepted:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (g *graphEdgeDefinitions) edgeCollectionNames(ctx context.Context) ([]string, error) {
+	url := g.graph.url("edge")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Collections           []string `json:"collections,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, g.graph.db.connection(), url, &response, g.graph.withModifiers()...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Collections, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func newEdgeCollection(g *graph, name string) *edgeCollection {
+	e := &edgeCollection{
+		graph: g,
+		name:  name,
+	}
+
+	e.edgeCollectionDocuments = newEdgeCollectionDocuments(e)
+
+	return e
+}
+
+var _ EdgeCollection = &edgeCollection{}
+
+type edgeCollection struct {
+	graph *graph
+	name  string
+
+	*edgeCollectionDocuments
+}
+
+func (e *edgeCollection) Name() string {
+	return e.name
+}
+
+func (e *edgeCollection) Graph() Graph {
+	return e.graph
+}
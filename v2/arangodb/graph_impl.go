@@ -0,0 +1,128 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newGraph(db *database, def GraphDefinition, modifiers ...connection.RequestModifier) *graph {
+	g := &graph{db: db, definition: def, modifiers: append(db.modifiers, modifiers...)}
+
+	g.graphVertexCollections = newGraphVertexCollections(g)
+	g.graphEdgeDefinitions = newGraphEdgeDefinitions(g)
+
+	return g
+}
+
+var _ Graph = &graph{}
+
+type graph struct {
+	db *database
+
+	definition GraphDefinition
+
+	modifiers []connection.RequestModifier
+
+	*graphVertexCollections
+	*graphEdgeDefinitions
+}
+
+func (g *graph) Name() string {
+	return g.definition.Name
+}
+
+func (g *graph) Database() Database {
+	return g.db
+}
+
+func (g *graph) Definition() GraphDefinition {
+	return g.definition
+}
+
+func (g *graph) IsSmart() bool {
+	return g.definition.IsSmart
+}
+
+func (g *graph) IsSatellite() bool {
+	return g.definition.IsSatellite
+}
+
+func (g *graph) IsDisjoint() bool {
+	return g.definition.IsDisjoint
+}
+
+func (g *graph) EdgeDefinitions() []EdgeDefinition {
+	return g.definition.EdgeDefinitions
+}
+
+func (g *graph) OrphanCollections() []string {
+	return g.definition.OrphanCollections
+}
+
+func (g *graph) Remove(ctx context.Context, opts *RemoveGraphOptions) error {
+	url := g.url()
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, g.db.connection(), url, &response, g.withModifiers(opts.modifyRequest)...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated, http.StatusAccepted:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (g *graph) withModifiers(modifiers ...connection.RequestModifier) []connection.RequestModifier {
+	if len(modifiers) == 0 {
+		return g.modifiers
+	}
+
+	z := len(g.modifiers)
+
+	d := make([]connection.RequestModifier, len(modifiers)+z)
+
+	copy(d, g.modifiers)
+
+	for i, v := range modifiers {
+		d[i+z] = v
+	}
+
+	return d
+}
+
+// url returns the path to this graph (`_db/<db-name>/_api/gharial/<graph-name>/<parts>`)
+func (g *graph) url(parts ...string) string {
+	return g.db.url(append([]string{"_api", "gharial", g.definition.Name}, parts...)...)
+}
@@ -0,0 +1,86 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+// VertexCollectionDocuments contains methods for vertex documents of a collection accessed through a named graph.
+// https://www.arangodb.com/docs/stable/http/gharial-vertices.html
+type VertexCollectionDocuments interface {
+	// ReadVertex reads a single vertex with given key from the collection.
+	// The document data is stored into result, the document metadata is returned.
+	// If no vertex exists with given key, a NotFoundError is returned.
+	ReadVertex(ctx context.Context, key string, result interface{}) (DocumentMeta, error)
+
+	// ReadVertexWithOptions reads a single vertex with given key from the collection.
+	// The document data is stored into result, the document metadata is returned.
+	// If no vertex exists with given key, a NotFoundError is returned.
+	ReadVertexWithOptions(ctx context.Context, key string, result interface{}, opts *VertexReadOptions) (DocumentMeta, error)
+
+	// CreateVertex creates a single vertex in the collection.
+	// If the document data already contains a `_key` field, this will be used as key of the new document,
+	// otherwise a unique key is created.
+	CreateVertex(ctx context.Context, vertex interface{}) (VertexCreateResponse, error)
+
+	// CreateVertexWithOptions creates a single vertex in the collection.
+	// If the document data already contains a `_key` field, this will be used as key of the new document,
+	// otherwise a unique key is created.
+	CreateVertexWithOptions(ctx context.Context, vertex interface{}, opts *VertexCreateOptions) (VertexCreateResponse, error)
+
+	// UpdateVertex partially updates a single vertex with given key in the collection.
+	// If no vertex exists with given key, a NotFoundError is returned.
+	UpdateVertex(ctx context.Context, key string, vertex interface{}) (VertexUpdateResponse, error)
+
+	// UpdateVertexWithOptions partially updates a single vertex with given key in the collection.
+	// If no vertex exists with given key, a NotFoundError is returned.
+	UpdateVertexWithOptions(ctx context.Context, key string, vertex interface{}, opts *VertexUpdateOptions) (VertexUpdateResponse, error)
+
+	// ReplaceVertex replaces a single vertex with given key in the collection.
+	// If no vertex exists with given key, a NotFoundError is returned.
+	ReplaceVertex(ctx context.Context, key string, vertex interface{}) (VertexReplaceResponse, error)
+
+	// ReplaceVertexWithOptions replaces a single vertex with given key in the collection.
+	// If no vertex exists with given key, a NotFoundError is returned.
+	ReplaceVertexWithOptions(ctx context.Context, key string, vertex interface{}, opts *VertexReplaceOptions) (VertexReplaceResponse, error)
+
+	// DeleteVertex removes a single vertex with given key from the collection.
+	// If no vertex exists with given key, a NotFoundError is returned.
+	DeleteVertex(ctx context.Context, key string) (VertexDeleteResponse, error)
+
+	// DeleteVertexWithOptions removes a single vertex with given key from the collection.
+	// If no vertex exists with given key, a NotFoundError is returned.
+	DeleteVertexWithOptions(ctx context.Context, key string, opts *VertexDeleteOptions) (VertexDeleteResponse, error)
+}
+
+type (
+	VertexCreateResponse  = GraphCollectionDocumentCreateResponse
+	VertexUpdateResponse  = GraphCollectionDocumentUpdateResponse
+	VertexReplaceResponse = GraphCollectionDocumentReplaceResponse
+	VertexDeleteResponse  = GraphCollectionDocumentDeleteResponse
+
+	VertexReadOptions    = GraphCollectionDocumentReadOptions
+	VertexCreateOptions  = GraphCollectionDocumentCreateOptions
+	VertexUpdateOptions  = GraphCollectionDocumentUpdateOptions
+	VertexReplaceOptions = GraphCollectionDocumentReplaceOptions
+	VertexDeleteOptions  = GraphCollectionDocumentDeleteOptions
+)
@@ -0,0 +1,83 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+func newVertexCollectionDocuments(collection *vertexCollection) *vertexCollectionDocuments {
+	return &vertexCollectionDocuments{
+		collection: collection,
+		documents: graphCollectionDocuments{
+			graph:      collection.graph,
+			kind:       "vertex",
+			collection: collection.name,
+		},
+	}
+}
+
+var _ VertexCollectionDocuments = &vertexCollectionDocuments{}
+
+type vertexCollectionDocuments struct {
+	collection *vertexCollection
+	documents  graphCollectionDocuments
+}
+
+func (c vertexCollectionDocuments) ReadVertex(ctx context.Context, key string, result interface{}) (DocumentMeta, error) {
+	return c.documents.read(ctx, key, result, nil)
+}
+
+func (c vertexCollectionDocuments) ReadVertexWithOptions(ctx context.Context, key string, result interface{}, opts *VertexReadOptions) (DocumentMeta, error) {
+	return c.documents.read(ctx, key, result, opts)
+}
+
+func (c vertexCollectionDocuments) CreateVertex(ctx context.Context, vertex interface{}) (VertexCreateResponse, error) {
+	return c.documents.create(ctx, vertex, nil)
+}
+
+func (c vertexCollectionDocuments) CreateVertexWithOptions(ctx context.Context, vertex interface{}, opts *VertexCreateOptions) (VertexCreateResponse, error) {
+	return c.documents.create(ctx, vertex, opts)
+}
+
+func (c vertexCollectionDocuments) UpdateVertex(ctx context.Context, key string, vertex interface{}) (VertexUpdateResponse, error) {
+	return c.documents.update(ctx, key, vertex, nil)
+}
+
+func (c vertexCollectionDocuments) UpdateVertexWithOptions(ctx context.Context, key string, vertex interface{}, opts *VertexUpdateOptions) (VertexUpdateResponse, error) {
+	return c.documents.update(ctx, key, vertex, opts)
+}
+
+func (c vertexCollectionDocuments) ReplaceVertex(ctx context.Context, key string, vertex interface{}) (VertexReplaceResponse, error) {
+	return c.documents.replace(ctx, key, vertex, nil)
+}
+
+func (c vertexCollectionDocuments) ReplaceVertexWithOptions(ctx context.Context, key string, vertex interface{}, opts *VertexReplaceOptions) (VertexReplaceResponse, error) {
+	return c.documents.replace(ctx, key, vertex, opts)
+}
+
+func (c vertexCollectionDocuments) DeleteVertex(ctx context.Context, key string) (VertexDeleteResponse, error) {
+	return c.documents.delete(ctx, key, nil)
+}
+
+func (c vertexCollectionDocuments) DeleteVertexWithOptions(ctx context.Context, key string, opts *VertexDeleteOptions) (VertexDeleteResponse, error) {
+	return c.documents.delete(ctx, key, opts)
+}
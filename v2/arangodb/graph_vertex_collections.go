@@ -0,0 +1,84 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// GraphVertexCollections provides access to all vertex collections of a single graph in a database.
+// https://www.arangodb.com/docs/stable/http/gharial-management.html#list-vertex-collections
+type GraphVertexCollections interface {
+	// VertexCollection opens a connection to an existing vertex-collection within the graph.
+	// If no vertex-collection with given name exists, an NotFoundError is returned.
+	VertexCollection(ctx context.Context, name string) (VertexCollection, error)
+
+	// VertexCollectionExists returns true if a vertex-collection with given name exists within the graph.
+	VertexCollectionExists(ctx context.Context, name string) (bool, error)
+
+	// VertexCollections returns all vertex collections of this graph.
+	VertexCollections(ctx context.Context) ([]VertexCollection, error)
+
+	// CreateVertexCollection adds a vertex collection to the graph.
+	// If the collection does not exist yet, it is created.
+	CreateVertexCollection(ctx context.Context, name string, opts *CreateVertexCollectionOptions) (VertexCollection, error)
+
+	// DeleteVertexCollection removes a vertex collection from the graph.
+	// The collection can only be removed if it is not used in any edge definition of the graph.
+	DeleteVertexCollection(ctx context.Context, name string, opts *DeleteVertexCollectionOptions) error
+}
+
+// VertexCollection is a vertex collection accessed through a named graph.
+type VertexCollection interface {
+	// Name returns the name of the collection.
+	Name() string
+
+	// Graph returns the graph containing the collection.
+	Graph() Graph
+
+	VertexCollectionDocuments
+}
+
+// CreateVertexCollectionOptions contains optional parameters for adding a vertex collection to a graph.
+type CreateVertexCollectionOptions struct {
+	// Satellites contains an array of collection names that will be used to create SatelliteCollections
+	// for a Hybrid (Disjoint) SmartGraph (Enterprise Edition only).
+	// Requires ArangoDB 3.9+
+	Satellites []string `json:"satellites,omitempty"`
+}
+
+type DeleteVertexCollectionOptions struct {
+	// DropCollection when set to true drops the collection as well.
+	// The collection is only dropped if it is not used in other graphs.
+	DropCollection *bool
+}
+
+func (o *DeleteVertexCollectionOptions) modifyRequest(r connection.Request) error {
+	if o == nil {
+		return nil
+	}
+	if o.DropCollection != nil {
+		r.AddQuery("dropCollection", boolToString(*o.DropCollection))
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newGraphVertexCollections(g *graph) *graphVertexCollections {
+	return &graphVertexCollections{
+		graph: g,
+	}
+}
+
+var _ GraphVertexCollections = &graphVertexCollections{}
+
+type graphVertexCollections struct {
+	graph *graph
+}
+
+func (g *graphVertexCollections) VertexCollection(ctx context.Context, name string) (VertexCollection, error) {
+	names, err := g.vertexCollectionNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, n := range names {
+		if n == name {
+			return newVertexCollection(g.graph, name), nil
+		}
+	}
+
+	return nil, shared.NewResponseStruct().AsArangoErrorWithCode(http.StatusNotFound)
+}
+
+func (g *graphVertexCollections) VertexCollectionExists(ctx context.Context, name string) (bool, error) {
+	_, err := g.VertexCollection(ctx, name)
+	if err == nil {
+		return true, nil
+	}
+
+	if shared.IsNotFound(err) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func (g *graphVertexCollections) VertexCollections(ctx context.Context) ([]VertexCollection, error) {
+	names, err := g.vertexCollectionNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]VertexCollection, len(names))
+	for i, n := range names {
+		result[i] = newVertexCollection(g.graph, n)
+	}
+
+	return result, nil
+}
+
+func (g *graphVertexCollections) CreateVertexCollection(ctx context.Context, name string, opts *CreateVertexCollectionOptions) (VertexCollection, error) {
+	url := g.graph.url("vertex")
+
+	input := struct {
+		Collection string                         `json:"collection"`
+		Options    *CreateVertexCollectionOptions `json:"options,omitempty"`
+	}{
+		Collection: name,
+		Options:    opts,
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallPost(ctx, g.graph.db.connection(), url, &response, input, g.graph.withModifiers()...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusCreated, http.StatusAccepted:
+		return newVertexCollection(g.graph, name), nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (g *graphVertexCollections) DeleteVertexCollection(ctx context.Context, name string, opts *DeleteVertexCollectionOptions) error {
+	url := g.graph.url("vertex", name)
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, g.graph.db.connection(), url, &response, g.graph.withModifiers(opts.modifyRequest)...)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusAccepted:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (g *graphVertexCollections) vertexCollectionNames(ctx context.Context) ([]string, error) {
+	url := g.graph.url("vertex")
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Collections           []string `json:"collections,omitempty"`
+	}
+
+	resp, err := connection.CallGet(ctx, g.graph.db.connection(), url, &response, g.graph.withModifiers()...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Collections, nil
+	default:
+		return nil, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func newVertexCollection(g *graph, name string) *vertexCollection {
+	v := &vertexCollection{
+		graph: g,
+		name:  name,
+	}
+
+	v.vertexCollectionDocuments = newVertexCollectionDocuments(v)
+
+	return v
+}
+
+var _ VertexCollection = &vertexCollection{}
+
+type vertexCollection struct {
+	graph *graph
+	name  string
+
+	*vertexCollectionDocuments
+}
+
+func (v *vertexCollection) Name() string {
+	return v.name
+}
+
+func (v *vertexCollection) Graph() Graph {
+	return v.graph
+}
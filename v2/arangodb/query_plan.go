@@ -0,0 +1,652 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// ExecutionNodeType is the type of a node of a query execution plan.
+type ExecutionNodeType string
+
+const (
+	ExecutionNodeTypeSingleton           ExecutionNodeType = "SingletonNode"
+	ExecutionNodeTypeEnumerateCollection ExecutionNodeType = "EnumerateCollectionNode"
+	ExecutionNodeTypeEnumerateList       ExecutionNodeType = "EnumerateListNode"
+	ExecutionNodeTypeEnumerateView       ExecutionNodeType = "EnumerateViewNode"
+	ExecutionNodeTypeIndex               ExecutionNodeType = "IndexNode"
+	ExecutionNodeTypeFilter              ExecutionNodeType = "FilterNode"
+	ExecutionNodeTypeLimit               ExecutionNodeType = "LimitNode"
+	ExecutionNodeTypeCalculation         ExecutionNodeType = "CalculationNode"
+	ExecutionNodeTypeSubquery            ExecutionNodeType = "SubqueryNode"
+	ExecutionNodeTypeSubqueryStart       ExecutionNodeType = "SubqueryStartNode"
+	ExecutionNodeTypeSubqueryEnd         ExecutionNodeType = "SubqueryEndNode"
+	ExecutionNodeTypeSort                ExecutionNodeType = "SortNode"
+	ExecutionNodeTypeCollect             ExecutionNodeType = "CollectNode"
+	ExecutionNodeTypeReturn              ExecutionNodeType = "ReturnNode"
+	ExecutionNodeTypeInsert              ExecutionNodeType = "InsertNode"
+	ExecutionNodeTypeUpdate              ExecutionNodeType = "UpdateNode"
+	ExecutionNodeTypeReplace             ExecutionNodeType = "ReplaceNode"
+	ExecutionNodeTypeRemove              ExecutionNodeType = "RemoveNode"
+	ExecutionNodeTypeUpsert              ExecutionNodeType = "UpsertNode"
+	ExecutionNodeTypeTraversal           ExecutionNodeType = "TraversalNode"
+	ExecutionNodeTypeShortestPath        ExecutionNodeType = "ShortestPathNode"
+	ExecutionNodeTypeKShortestPaths      ExecutionNodeType = "KShortestPathsNode"
+	ExecutionNodeTypeEnumeratePaths      ExecutionNodeType = "EnumeratePathsNode"
+	ExecutionNodeTypeNoResults           ExecutionNodeType = "NoResultsNode"
+	ExecutionNodeTypeMaterialize         ExecutionNodeType = "MaterializeNode"
+	ExecutionNodeTypeWindow              ExecutionNodeType = "WindowNode"
+	ExecutionNodeTypeRemote              ExecutionNodeType = "RemoteNode"
+	ExecutionNodeTypeScatter             ExecutionNodeType = "ScatterNode"
+	ExecutionNodeTypeDistribute          ExecutionNodeType = "DistributeNode"
+	ExecutionNodeTypeGather              ExecutionNodeType = "GatherNode"
+)
+
+// ExecutionNode is a typed node of a query execution plan.
+// The nodes with known types are returned as *EnumerateCollectionNode, *IndexNode, *TraversalNode, etc.,
+// all other nodes are returned as *ExecutionNodeBase.
+type ExecutionNode interface {
+	// Base returns the attributes which are common for all nodes.
+	Base() *ExecutionNodeBase
+}
+
+// ExecutionNodeBase holds the attributes which are common for all nodes.
+type ExecutionNodeBase struct {
+	// ID of the node, unique within the plan.
+	ID int `json:"id"`
+	// Type of the node.
+	Type ExecutionNodeType `json:"type"`
+	// Dependencies holds the IDs of the nodes which provide the input of this node.
+	Dependencies []int `json:"dependencies,omitempty"`
+	// EstimatedCost is the estimated cost of the node and all its dependencies.
+	EstimatedCost float64 `json:"estimatedCost,omitempty"`
+	// EstimatedNrItems is the estimated number of items produced by the node.
+	EstimatedNrItems int `json:"estimatedNrItems,omitempty"`
+	// Raw holds all attributes of the node as returned by the server, including the ones which are not typed.
+	Raw map[string]interface{} `json:"-"`
+}
+
+func (n *ExecutionNodeBase) Base() *ExecutionNodeBase {
+	return n
+}
+
+// ExecutionNodeVariable is a variable used by a node.
+type ExecutionNodeVariable struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ExecutionNodeExpression is a node of an expression tree, e.g. a condition or a calculation.
+type ExecutionNodeExpression struct {
+	// Type of the expression node, e.g. "compare ==", "attribute access", "value" or "function call".
+	Type string `json:"type"`
+	// Name is the name of an attribute, a variable or a function.
+	Name string `json:"name,omitempty"`
+	// Value is the value of a constant.
+	Value interface{} `json:"value,omitempty"`
+	// SubNodes holds the operands.
+	SubNodes []ExecutionNodeExpression `json:"subNodes,omitempty"`
+}
+
+// ExecutionNodeIndex describes an index used by a node.
+type ExecutionNodeIndex struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name,omitempty"`
+	Type   string   `json:"type"`
+	Fields []string `json:"fields,omitempty"`
+	Unique bool     `json:"unique,omitempty"`
+	Sparse bool     `json:"sparse,omitempty"`
+	// SelectivityEstimate is the estimated selectivity of the index, if it supports it.
+	SelectivityEstimate float64 `json:"selectivityEstimate,omitempty"`
+}
+
+// EnumerateCollectionNode reads all documents of a collection (a full collection scan).
+type EnumerateCollectionNode struct {
+	ExecutionNodeBase
+	Database    string                `json:"database"`
+	Collection  string                `json:"collection"`
+	OutVariable ExecutionNodeVariable `json:"outVariable"`
+	// Random is true when the documents are read in random order (e.g. for RAND() sorting with LIMIT 1).
+	Random bool `json:"random,omitempty"`
+	// Projections holds the attributes which are read from the documents, if not the whole documents are needed.
+	Projections []interface{} `json:"projections,omitempty"`
+	// Filter is the condition applied to the documents while reading them, if any.
+	Filter *ExecutionNodeExpression `json:"filter,omitempty"`
+}
+
+// IndexNode reads documents of a collection using one or more indexes.
+type IndexNode struct {
+	ExecutionNodeBase
+	Database    string                `json:"database"`
+	Collection  string                `json:"collection"`
+	OutVariable ExecutionNodeVariable `json:"outVariable"`
+	Indexes     []ExecutionNodeIndex  `json:"indexes,omitempty"`
+	// Condition is the condition used to look up the indexes. It is empty when the whole index is read.
+	Condition   *ExecutionNodeExpression `json:"condition,omitempty"`
+	Reverse     bool                     `json:"reverse,omitempty"`
+	Projections []interface{}            `json:"projections,omitempty"`
+	// Filter is the condition applied to the documents while reading them, if any.
+	Filter *ExecutionNodeExpression `json:"filter,omitempty"`
+}
+
+// IsFullScan returns true when the node reads the whole index, e.g. to produce a sorted result.
+func (n *IndexNode) IsFullScan() bool {
+	return n.Condition == nil || len(n.Condition.SubNodes) == 0
+}
+
+// EnumerateListNode iterates over the elements of an array.
+type EnumerateListNode struct {
+	ExecutionNodeBase
+	InVariable  ExecutionNodeVariable `json:"inVariable"`
+	OutVariable ExecutionNodeVariable `json:"outVariable"`
+}
+
+// EnumerateViewNode reads documents from a view.
+type EnumerateViewNode struct {
+	ExecutionNodeBase
+	Database    string                `json:"database"`
+	View        string                `json:"view"`
+	OutVariable ExecutionNodeVariable `json:"outVariable"`
+}
+
+// FilterNode filters the items by the value of a variable.
+type FilterNode struct {
+	ExecutionNodeBase
+	InVariable ExecutionNodeVariable `json:"inVariable"`
+}
+
+// CalculationNode evaluates an expression.
+type CalculationNode struct {
+	ExecutionNodeBase
+	OutVariable ExecutionNodeVariable   `json:"outVariable"`
+	Expression  ExecutionNodeExpression `json:"expression"`
+	// ExpressionType is "simple", "attribute" or "v8" when the expression must be evaluated by JavaScript.
+	ExpressionType string `json:"expressionType,omitempty"`
+	CanThrow       bool   `json:"canThrow,omitempty"`
+}
+
+// LimitNode limits the number of items.
+type LimitNode struct {
+	ExecutionNodeBase
+	Offset    int  `json:"offset"`
+	Limit     int  `json:"limit"`
+	FullCount bool `json:"fullCount,omitempty"`
+}
+
+// SortNode sorts the items.
+type SortNode struct {
+	ExecutionNodeBase
+	Elements []SortNodeElement `json:"elements,omitempty"`
+	Stable   bool              `json:"stable,omitempty"`
+	// Strategy is the sort algorithm, e.g. "standard" or "constrained-heap".
+	Strategy string `json:"strategy,omitempty"`
+	// Limit is the number of items to keep when the sort is combined with a LIMIT.
+	Limit int `json:"limit,omitempty"`
+}
+
+// SortNodeElement is a sort criterion.
+type SortNodeElement struct {
+	InVariable ExecutionNodeVariable `json:"inVariable"`
+	Ascending  bool                  `json:"ascending"`
+}
+
+// CollectNode groups the items.
+type CollectNode struct {
+	ExecutionNodeBase
+	Groups         []CollectNodeVariable    `json:"groups,omitempty"`
+	Aggregates     []CollectNodeVariable    `json:"aggregates,omitempty"`
+	CollectOptions CollectNodeOptions       `json:"collectOptions,omitempty"`
+	OutVariable    *ExecutionNodeVariable   `json:"outVariable,omitempty"`
+	Expression     *ExecutionNodeExpression `json:"expression,omitempty"`
+}
+
+// CollectNodeVariable is a group or an aggregate of a CollectNode.
+type CollectNodeVariable struct {
+	OutVariable ExecutionNodeVariable `json:"outVariable"`
+	InVariable  ExecutionNodeVariable `json:"inVariable"`
+	// Type is the aggregate function, e.g. "SUM" or "LENGTH". It is empty for groups.
+	Type string `json:"type,omitempty"`
+}
+
+// CollectNodeOptions describes how a CollectNode groups the items.
+type CollectNodeOptions struct {
+	// Method is "hash", "sorted", "count" or "distinct".
+	Method string `json:"method,omitempty"`
+}
+
+// ReturnNode returns the items as the result of the (sub)query.
+type ReturnNode struct {
+	ExecutionNodeBase
+	InVariable ExecutionNodeVariable `json:"inVariable"`
+}
+
+// SubqueryNode executes a subquery for each item.
+type SubqueryNode struct {
+	ExecutionNodeBase
+	OutVariable ExecutionNodeVariable `json:"outVariable"`
+	IsConst     bool                  `json:"isConst,omitempty"`
+	// Subquery is the plan of the subquery.
+	Subquery ExecutionPlan `json:"-"`
+}
+
+// ModificationNode inserts, updates, replaces, removes or upserts documents.
+type ModificationNode struct {
+	ExecutionNodeBase
+	Database       string                 `json:"database"`
+	Collection     string                 `json:"collection"`
+	InVariable     *ExecutionNodeVariable `json:"inVariable,omitempty"`
+	InDocVariable  *ExecutionNodeVariable `json:"inDocVariable,omitempty"`
+	InKeyVariable  *ExecutionNodeVariable `json:"inKeyVariable,omitempty"`
+	OutVariableNew *ExecutionNodeVariable `json:"outVariableNew,omitempty"`
+	OutVariableOld *ExecutionNodeVariable `json:"outVariableOld,omitempty"`
+}
+
+// TraversalNode traverses a graph.
+type TraversalNode struct {
+	ExecutionNodeBase
+	Database string `json:"database"`
+	// Graph is the name of the named graph, or the list of edge collections of an anonymous graph.
+	Graph             interface{}            `json:"graph,omitempty"`
+	EdgeCollections   []string               `json:"edgeCollections,omitempty"`
+	VertexCollections []string               `json:"vertexCollections,omitempty"`
+	InVariable        *ExecutionNodeVariable `json:"inVariable,omitempty"`
+	// VertexID is the start vertex when it is a constant.
+	VertexID          string                 `json:"vertexId,omitempty"`
+	VertexOutVariable *ExecutionNodeVariable `json:"vertexOutVariable,omitempty"`
+	EdgeOutVariable   *ExecutionNodeVariable `json:"edgeOutVariable,omitempty"`
+	PathOutVariable   *ExecutionNodeVariable `json:"pathOutVariable,omitempty"`
+	Options           TraversalNodeOptions   `json:"options,omitempty"`
+}
+
+// TraversalNodeOptions holds the options of a traversal.
+type TraversalNodeOptions struct {
+	MinDepth int `json:"minDepth"`
+	MaxDepth int `json:"maxDepth"`
+}
+
+// ShortestPathNode finds the shortest path or paths between two vertices.
+// It is also used for KShortestPathsNode and EnumeratePathsNode.
+type ShortestPathNode struct {
+	ExecutionNodeBase
+	Database string `json:"database"`
+	// Graph is the name of the named graph, or the list of edge collections of an anonymous graph.
+	Graph             interface{}            `json:"graph,omitempty"`
+	VertexOutVariable *ExecutionNodeVariable `json:"vertexOutVariable,omitempty"`
+	EdgeOutVariable   *ExecutionNodeVariable `json:"edgeOutVariable,omitempty"`
+}
+
+// ExecutionPlan is a typed query execution plan.
+// Use CursorPlan.ExecutionPlan or ExplainQueryResultPlan.ExecutionPlan to create it.
+type ExecutionPlan struct {
+	// Nodes holds the nodes in the order returned by the server, which ends with the root node.
+	Nodes []ExecutionNode
+
+	byID map[int]ExecutionNode
+}
+
+// NewExecutionPlan creates a typed plan from the raw nodes returned by the server.
+func NewExecutionPlan(nodes []map[string]interface{}) (ExecutionPlan, error) {
+	plan := ExecutionPlan{
+		Nodes: make([]ExecutionNode, 0, len(nodes)),
+		byID:  make(map[int]ExecutionNode, len(nodes)),
+	}
+
+	for _, raw := range nodes {
+		node, err := newExecutionNode(raw)
+		if err != nil {
+			return ExecutionPlan{}, err
+		}
+
+		plan.Nodes = append(plan.Nodes, node)
+		plan.byID[node.Base().ID] = node
+	}
+
+	return plan, nil
+}
+
+// ExecutionPlan returns the typed execution plan.
+func (c CursorPlan) ExecutionPlan() (ExecutionPlan, error) {
+	nodes := make([]map[string]interface{}, len(c.Nodes))
+	for i, node := range c.Nodes {
+		nodes[i] = node
+	}
+
+	return NewExecutionPlan(nodes)
+}
+
+// ExecutionPlan returns the typed execution plan.
+func (e ExplainQueryResultPlan) ExecutionPlan() (ExecutionPlan, error) {
+	nodes := make([]map[string]interface{}, len(e.NodesRaw))
+	for i, node := range e.NodesRaw {
+		nodes[i] = node
+	}
+
+	return NewExecutionPlan(nodes)
+}
+
+// Node returns the node with the given ID or nil if there is no such node.
+func (p ExecutionPlan) Node(id int) ExecutionNode {
+	return p.byID[id]
+}
+
+// Root returns the node which produces the result of the plan, or nil when the plan is empty.
+func (p ExecutionPlan) Root() ExecutionNode {
+	dependencies := map[int]bool{}
+	for _, node := range p.Nodes {
+		for _, id := range node.Base().Dependencies {
+			dependencies[id] = true
+		}
+	}
+
+	for i := len(p.Nodes) - 1; i >= 0; i-- {
+		if !dependencies[p.Nodes[i].Base().ID] {
+			return p.Nodes[i]
+		}
+	}
+
+	return nil
+}
+
+// Dependencies returns the nodes which provide the input of the given node.
+func (p ExecutionPlan) Dependencies(node ExecutionNode) []ExecutionNode {
+	var result []ExecutionNode
+	for _, id := range node.Base().Dependencies {
+		if dependency, ok := p.byID[id]; ok {
+			result = append(result, dependency)
+		}
+	}
+
+	return result
+}
+
+// Dependents returns the nodes which consume the output of the given node.
+func (p ExecutionPlan) Dependents(node ExecutionNode) []ExecutionNode {
+	var result []ExecutionNode
+	for _, n := range p.Nodes {
+		for _, id := range n.Base().Dependencies {
+			if id == node.Base().ID {
+				result = append(result, n)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// Walk calls the function for each node starting from the root node and following the dependencies.
+// The depth is the distance from the root node. Subqueries are not visited, use SubqueryNode.Subquery for them.
+// The walk stops when the function returns false.
+func (p ExecutionPlan) Walk(f func(node ExecutionNode, depth int) bool) {
+	root := p.Root()
+	if root == nil {
+		return
+	}
+
+	visited := map[int]bool{}
+
+	var walk func(node ExecutionNode, depth int) bool
+	walk = func(node ExecutionNode, depth int) bool {
+		if visited[node.Base().ID] {
+			return true
+		}
+		visited[node.Base().ID] = true
+
+		if !f(node, depth) {
+			return false
+		}
+
+		for _, dependency := range p.Dependencies(node) {
+			if !walk(dependency, depth+1) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	walk(root, 0)
+}
+
+// FullScans returns the nodes which read whole collections or indexes, including the ones in subqueries.
+// These are all EnumerateCollectionNodes, and the IndexNodes which read the whole index.
+func (p ExecutionPlan) FullScans() []ExecutionNode {
+	var result []ExecutionNode
+	for _, node := range p.Nodes {
+		switch n := node.(type) {
+		case *EnumerateCollectionNode:
+			result = append(result, n)
+		case *IndexNode:
+			if n.IsFullScan() {
+				result = append(result, n)
+			}
+		case *SubqueryNode:
+			result = append(result, n.Subquery.FullScans()...)
+		}
+	}
+
+	return result
+}
+
+// newExecutionNode creates the typed node from the raw node.
+func newExecutionNode(raw map[string]interface{}) (ExecutionNode, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var base ExecutionNodeBase
+	if err := json.Unmarshal(data, &base); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var node ExecutionNode
+	switch base.Type {
+	case ExecutionNodeTypeEnumerateCollection:
+		node = &EnumerateCollectionNode{}
+	case ExecutionNodeTypeIndex:
+		node = &IndexNode{}
+	case ExecutionNodeTypeEnumerateList:
+		node = &EnumerateListNode{}
+	case ExecutionNodeTypeEnumerateView:
+		node = &EnumerateViewNode{}
+	case ExecutionNodeTypeFilter:
+		node = &FilterNode{}
+	case ExecutionNodeTypeCalculation:
+		node = &CalculationNode{}
+	case ExecutionNodeTypeLimit:
+		node = &LimitNode{}
+	case ExecutionNodeTypeSort:
+		node = &SortNode{}
+	case ExecutionNodeTypeCollect:
+		node = &CollectNode{}
+	case ExecutionNodeTypeReturn:
+		node = &ReturnNode{}
+	case ExecutionNodeTypeSubquery:
+		subquery := &SubqueryNode{}
+		if err := subquery.parseSubquery(raw); err != nil {
+			return nil, err
+		}
+		node = subquery
+	case ExecutionNodeTypeInsert, ExecutionNodeTypeUpdate, ExecutionNodeTypeReplace, ExecutionNodeTypeRemove,
+		ExecutionNodeTypeUpsert:
+		node = &ModificationNode{}
+	case ExecutionNodeTypeTraversal:
+		node = &TraversalNode{}
+	case ExecutionNodeTypeShortestPath, ExecutionNodeTypeKShortestPaths, ExecutionNodeTypeEnumeratePaths:
+		node = &ShortestPathNode{}
+	default:
+		node = &ExecutionNodeBase{}
+	}
+
+	if err := json.Unmarshal(data, node); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %s", base.Type)
+	}
+	node.Base().Raw = raw
+
+	return node, nil
+}
+
+// parseSubquery creates the plan of the subquery from the raw node.
+func (n *SubqueryNode) parseSubquery(raw map[string]interface{}) error {
+	subquery, _ := raw["subquery"].(map[string]interface{})
+	rawNodes, _ := subquery["nodes"].([]interface{})
+
+	nodes := make([]map[string]interface{}, 0, len(rawNodes))
+	for _, rawNode := range rawNodes {
+		if node, ok := rawNode.(map[string]interface{}); ok {
+			nodes = append(nodes, node)
+		}
+	}
+
+	plan, err := NewExecutionPlan(nodes)
+	if err != nil {
+		return err
+	}
+	n.Subquery = plan
+
+	return nil
+}
+
+// CursorStatsNode holds the execution statistics of a single plan node.
+// They are returned when the query is profiled with QuerySubOptions.Profile set to 2.
+type CursorStatsNode struct {
+	// ID of the plan node.
+	ID int `json:"id"`
+	// Calls is the number of calls to the node.
+	Calls uint64 `json:"calls"`
+	// Items is the number of items returned by the node.
+	Items uint64 `json:"items"`
+	// Filtered is the number of items filtered out by the node.
+	Filtered uint64 `json:"filtered,omitempty"`
+	// Runtime is the time in seconds spent in the node and all its dependencies.
+	Runtime float64 `json:"runtime"`
+	// PeakMemoryUsage is the peak memory usage of the node in bytes.
+	PeakMemoryUsage uint64 `json:"peakMemoryUsage,omitempty"`
+}
+
+// CursorProfile holds the duration in seconds of the query execution phases,
+// e.g. "parsing", "optimizing plan" or "executing".
+// It is returned when the query is profiled with QuerySubOptions.Profile set to 1 or 2.
+type CursorProfile map[string]float64
+
+// QueryProfile joins the execution statistics of the nodes with the execution plan.
+type QueryProfile struct {
+	// Plan is the executed plan.
+	Plan ExecutionPlan
+	// Phases holds the duration of the execution phases.
+	Phases CursorProfile
+	// Nodes holds the nodes of the plan with their statistics, in the order of the plan.
+	Nodes []QueryProfileNode
+}
+
+// QueryProfileNode is a node of the plan with its execution statistics.
+type QueryProfileNode struct {
+	ExecutionNode
+	// Stats holds the statistics of the node. It is empty when the server did not return them.
+	Stats CursorStatsNode
+	// OwnRuntime is the time in seconds spent in the node itself, without its dependencies.
+	OwnRuntime float64
+}
+
+// NewQueryProfile creates the profile from the plan, statistics and profile returned for a profiled query.
+// The query must be run with QuerySubOptions.Profile set to 2 to get the statistics of each node.
+func NewQueryProfile(plan CursorPlan, stats CursorStats, phases CursorProfile) (QueryProfile, error) {
+	executionPlan, err := plan.ExecutionPlan()
+	if err != nil {
+		return QueryProfile{}, err
+	}
+
+	profile := QueryProfile{
+		Plan:   executionPlan,
+		Phases: phases,
+		Nodes:  make([]QueryProfileNode, 0, len(executionPlan.Nodes)),
+	}
+
+	nodeStats := make(map[int]CursorStatsNode, len(stats.Nodes))
+	for _, s := range stats.Nodes {
+		nodeStats[s.ID] = s
+	}
+
+	for _, node := range executionPlan.Nodes {
+		s := nodeStats[node.Base().ID]
+
+		own := s.Runtime
+		for _, id := range node.Base().Dependencies {
+			own -= nodeStats[id].Runtime
+		}
+		if own < 0 {
+			own = 0
+		}
+
+		profile.Nodes = append(profile.Nodes, QueryProfileNode{ExecutionNode: node, Stats: s, OwnRuntime: own})
+	}
+
+	return profile, nil
+}
+
+// Node returns the node with the given ID, or false when there is no such node.
+func (q QueryProfile) Node(id int) (QueryProfileNode, bool) {
+	for _, node := range q.Nodes {
+		if node.Base().ID == id {
+			return node, true
+		}
+	}
+
+	return QueryProfileNode{}, false
+}
+
+// FullScans returns the nodes of the top level plan which read whole collections or indexes, with their statistics.
+func (q QueryProfile) FullScans() []QueryProfileNode {
+	var result []QueryProfileNode
+	for _, node := range q.Nodes {
+		switch n := node.ExecutionNode.(type) {
+		case *EnumerateCollectionNode:
+			result = append(result, node)
+		case *IndexNode:
+			if n.IsFullScan() {
+				result = append(result, node)
+			}
+		}
+	}
+
+	return result
+}
+
+// Slowest returns up to n nodes which spent the most time on their own, the slowest first.
+func (q QueryProfile) Slowest(n int) []QueryProfileNode {
+	nodes := append([]QueryProfileNode(nil), q.Nodes...)
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].OwnRuntime > nodes[j].OwnRuntime
+	})
+
+	if n < 0 {
+		n = 0
+	}
+	if n < len(nodes) {
+		nodes = nodes[:n]
+	}
+
+	return nodes
+}
@@ -0,0 +1,207 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// profiledCursorData is a shortened response for a query profiled with level 2:
+// FOR d IN users FILTER d.age > 18 FOR s IN sessions SORT s.date RETURN [d, (FOR x IN [1] RETURN x)]
+const profiledCursorData = `{
+  "extra": {
+    "plan": {
+      "nodes": [
+        {"type": "SingletonNode", "id": 1, "dependencies": [], "estimatedCost": 1, "estimatedNrItems": 1},
+        {"type": "EnumerateCollectionNode", "id": 2, "dependencies": [1], "estimatedCost": 102,
+         "estimatedNrItems": 100, "database": "db", "collection": "users", "random": false,
+         "outVariable": {"id": 0, "name": "d"}},
+        {"type": "CalculationNode", "id": 3, "dependencies": [2], "estimatedCost": 202, "estimatedNrItems": 100,
+         "outVariable": {"id": 3, "name": "3"}, "expressionType": "simple",
+         "expression": {"type": "compare >", "subNodes": [
+           {"type": "attribute access", "name": "age", "subNodes": [{"type": "reference", "name": "d", "id": 0}]},
+           {"type": "value", "value": 18}]}},
+        {"type": "FilterNode", "id": 4, "dependencies": [3], "estimatedCost": 302, "estimatedNrItems": 100,
+         "inVariable": {"id": 3, "name": "3"}},
+        {"type": "IndexNode", "id": 5, "dependencies": [4], "estimatedCost": 1000, "estimatedNrItems": 1000,
+         "database": "db", "collection": "sessions", "outVariable": {"id": 1, "name": "s"}, "reverse": false,
+         "condition": {}, "indexes": [{"id": "7", "name": "idx_date", "type": "persistent", "fields": ["date"],
+         "unique": false, "sparse": false, "selectivityEstimate": 0.5}]},
+        {"type": "SubqueryNode", "id": 6, "dependencies": [5], "estimatedCost": 1100, "estimatedNrItems": 1000,
+         "outVariable": {"id": 5, "name": "5"}, "isConst": true,
+         "subquery": {"nodes": [
+           {"type": "SingletonNode", "id": 10, "dependencies": []},
+           {"type": "EnumerateCollectionNode", "id": 11, "dependencies": [10], "database": "db",
+            "collection": "logs", "outVariable": {"id": 6, "name": "x"}},
+           {"type": "ReturnNode", "id": 12, "dependencies": [11], "inVariable": {"id": 6, "name": "x"}}]}},
+        {"type": "ScatterNode", "id": 7, "dependencies": [6], "estimatedCost": 1200, "estimatedNrItems": 1000},
+        {"type": "ReturnNode", "id": 8, "dependencies": [7], "estimatedCost": 1300, "estimatedNrItems": 1000,
+         "inVariable": {"id": 5, "name": "5"}}
+      ],
+      "rules": ["use-index-for-sort"],
+      "estimatedCost": 1300,
+      "estimatedNrItems": 1000
+    },
+    "stats": {
+      "scannedFull": 100,
+      "scannedIndex": 1000,
+      "peakMemoryUsage": 32768,
+      "nodes": [
+        {"id": 1, "calls": 1, "items": 1, "runtime": 0.0001},
+        {"id": 2, "calls": 2, "items": 100, "runtime": 0.0021},
+        {"id": 3, "calls": 2, "items": 100, "runtime": 0.0031},
+        {"id": 4, "calls": 2, "items": 20, "filtered": 80, "runtime": 0.0036},
+        {"id": 5, "calls": 20, "items": 1000, "runtime": 0.0436, "peakMemoryUsage": 16384},
+        {"id": 6, "calls": 20, "items": 1000, "runtime": 0.0536},
+        {"id": 7, "calls": 20, "items": 1000, "runtime": 0.0537},
+        {"id": 8, "calls": 20, "items": 1000, "runtime": 0.0540}
+      ]
+    },
+    "profile": {"parsing": 0.0001, "optimizing plan": 0.0004, "executing": 0.0541}
+  }
+}`
+
+func Test_ExecutionPlan(t *testing.T) {
+	var data cursorData
+	require.NoError(t, json.Unmarshal([]byte(profiledCursorData), &data))
+
+	plan, err := data.Extra.Plan.ExecutionPlan()
+	require.NoError(t, err)
+	require.Len(t, plan.Nodes, 8)
+
+	t.Run("typed nodes", func(t *testing.T) {
+		enumerate, ok := plan.Node(2).(*EnumerateCollectionNode)
+		require.True(t, ok)
+		assert.Equal(t, "users", enumerate.Collection)
+		assert.Equal(t, "d", enumerate.OutVariable.Name)
+		assert.Equal(t, []int{1}, enumerate.Dependencies)
+		assert.Equal(t, 100, enumerate.EstimatedNrItems)
+		assert.Equal(t, "users", enumerate.Raw["collection"])
+
+		calculation, ok := plan.Node(3).(*CalculationNode)
+		require.True(t, ok)
+		assert.Equal(t, "compare >", calculation.Expression.Type)
+		require.Len(t, calculation.Expression.SubNodes, 2)
+		assert.Equal(t, "age", calculation.Expression.SubNodes[0].Name)
+		assert.EqualValues(t, 18, calculation.Expression.SubNodes[1].Value)
+
+		filter, ok := plan.Node(4).(*FilterNode)
+		require.True(t, ok)
+		assert.Equal(t, 3, filter.InVariable.ID)
+
+		index, ok := plan.Node(5).(*IndexNode)
+		require.True(t, ok)
+		assert.Equal(t, "sessions", index.Collection)
+		require.Len(t, index.Indexes, 1)
+		assert.Equal(t, []string{"date"}, index.Indexes[0].Fields)
+		assert.True(t, index.IsFullScan())
+
+		subquery, ok := plan.Node(6).(*SubqueryNode)
+		require.True(t, ok)
+		require.Len(t, subquery.Subquery.Nodes, 3)
+		assert.IsType(t, &EnumerateCollectionNode{}, subquery.Subquery.Node(11))
+
+		generic, ok := plan.Node(7).(*ExecutionNodeBase)
+		require.True(t, ok)
+		assert.Equal(t, ExecutionNodeTypeScatter, generic.Type)
+
+		assert.Nil(t, plan.Node(100))
+	})
+
+	t.Run("dependencies", func(t *testing.T) {
+		root := plan.Root()
+		require.NotNil(t, root)
+		assert.Equal(t, 8, root.Base().ID)
+
+		dependencies := plan.Dependencies(plan.Node(5))
+		require.Len(t, dependencies, 1)
+		assert.Equal(t, 4, dependencies[0].Base().ID)
+
+		dependents := plan.Dependents(plan.Node(5))
+		require.Len(t, dependents, 1)
+		assert.Equal(t, 6, dependents[0].Base().ID)
+
+		var ids, depths []int
+		plan.Walk(func(node ExecutionNode, depth int) bool {
+			ids = append(ids, node.Base().ID)
+			depths = append(depths, depth)
+			return node.Base().ID != 4
+		})
+		assert.Equal(t, []int{8, 7, 6, 5, 4}, ids)
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, depths)
+	})
+
+	t.Run("full scans", func(t *testing.T) {
+		var collections []string
+		for _, node := range plan.FullScans() {
+			switch n := node.(type) {
+			case *EnumerateCollectionNode:
+				collections = append(collections, n.Collection)
+			case *IndexNode:
+				collections = append(collections, n.Collection)
+			}
+		}
+		assert.Equal(t, []string{"users", "sessions", "logs"}, collections)
+	})
+}
+
+func Test_QueryProfile(t *testing.T) {
+	var data cursorData
+	require.NoError(t, json.Unmarshal([]byte(profiledCursorData), &data))
+
+	c := &cursor{data: data}
+	assert.EqualValues(t, 32768, c.Statistics().PeakMemoryUsage)
+	assert.Equal(t, 0.0541, c.Profile()["executing"])
+
+	profile, err := NewQueryProfile(c.Plan(), c.Statistics(), c.Profile())
+	require.NoError(t, err)
+	require.Len(t, profile.Nodes, 8)
+
+	node, ok := profile.Node(4)
+	require.True(t, ok)
+	assert.IsType(t, &FilterNode{}, node.ExecutionNode)
+	assert.EqualValues(t, 20, node.Stats.Items)
+	assert.EqualValues(t, 80, node.Stats.Filtered)
+	assert.InDelta(t, 0.0005, node.OwnRuntime, 1e-9)
+
+	_, ok = profile.Node(100)
+	assert.False(t, ok)
+
+	scans := profile.FullScans()
+	require.Len(t, scans, 2)
+	assert.Equal(t, 2, scans[0].Base().ID)
+	assert.EqualValues(t, 100, scans[0].Stats.Items)
+	assert.Equal(t, 5, scans[1].Base().ID)
+	assert.EqualValues(t, 1000, scans[1].Stats.Items)
+	assert.EqualValues(t, 16384, scans[1].Stats.PeakMemoryUsage)
+
+	slowest := profile.Slowest(2)
+	require.Len(t, slowest, 2)
+	assert.Equal(t, 5, slowest[0].Base().ID)
+	assert.Equal(t, 6, slowest[1].Base().ID)
+
+	assert.Empty(t, profile.Slowest(-1))
+	assert.Len(t, profile.Slowest(100), len(profile.Nodes))
+}
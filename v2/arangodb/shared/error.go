@@ -36,8 +36,9 @@ const (
 	ErrDisabled       = 36
 
 	// HTTP error status codes
-	ErrHttpForbidden = 403
-	ErrHttpInternal  = 501
+	ErrHttpForbidden          = 403
+	ErrHttpInternal           = 501
+	ErrHttpServiceUnavailable = 503
 
 	// Internal ArangoDB storage errors
 	ErrArangoReadOnly = 1004
@@ -60,11 +61,14 @@ const (
 
 	// ArangoDB cluster errors
 	ErrClusterReplicationWriteConcernNotFulfilled = 1429
+	ErrClusterBackendUnavailable                  = 1478
 	ErrClusterLeadershipChallengeOngoing          = 1495
 	ErrClusterNotLeader                           = 1496
 
 	// User management errors
-	ErrUserDuplicate = 1702
+	ErrUserInvalidName = 1700
+	ErrUserDuplicate   = 1702
+	ErrUserNotFound    = 1703
 )
 
 // ArangoError is a Go error with arangodb specific error information.
@@ -163,7 +167,7 @@ func IsForbidden(err error) bool {
 // IsNotFound returns true if the given error is an ArangoError with code 404, indicating a object not found.
 func IsNotFound(err error) bool {
 	return IsArangoErrorWithCode(err, http.StatusNotFound) ||
-		IsArangoErrorWithErrorNum(err, ErrArangoDocumentNotFound, ErrArangoDataSourceNotFound)
+		IsArangoErrorWithErrorNum(err, ErrArangoDocumentNotFound, ErrArangoDataSourceNotFound, ErrUserNotFound)
 }
 
 // IsOperationTimeout returns true if the given error is an ArangoError with code 412, indicating a Operation timeout error
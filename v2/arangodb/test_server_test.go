@@ -0,0 +1,63 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// newTestServer starts the HTTP server with the handler. The server is closed when the test ends.
+func newTestServer(t *testing.T, handler http.Handler) *httptest.Server {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// newTestClient returns the client with the JSON HTTP connection to the servers.
+func newTestClient(urls ...string) *client {
+	return newClient(connection.NewHttpConnection(connection.HttpConfiguration{
+		Endpoint:    connection.NewRoundRobinEndpoints(urls),
+		ContentType: connection.ApplicationJSON,
+	}))
+}
+
+// writeTestResponse writes the JSON response with the status code.
+func writeTestResponse(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", connection.ApplicationJSON)
+	w.WriteHeader(code)
+	if body != nil {
+		data, _ := json.Marshal(body)
+		w.Write(data)
+	}
+}
+
+// writeTestError writes the ArangoDB error response with the status code and the error number.
+func writeTestError(w http.ResponseWriter, code, errorNum int) {
+	writeTestResponse(w, code, map[string]interface{}{
+		"error": true, "code": code, "errorNum": errorNum, "errorMessage": "failed",
+	})
+}
@@ -0,0 +1,102 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+)
+
+// User provides access to a single user of a single server / cluster of servers.
+// Database and collection names passed to the access methods may be set to `*` (GrantWildcard)
+// in order to manage the default access level of the user.
+type User interface {
+	// Name returns the name of the user.
+	Name() string
+
+	// IsActive returns true if the user is active.
+	IsActive() bool
+
+	// IsPasswordChangeNeeded returns true if a password change for this user is needed.
+	IsPasswordChangeNeeded() bool
+
+	// Extra gets extra information about this user that was passed during its creation/update/replacement.
+	Extra(result interface{}) error
+
+	// AccessibleDatabases returns a map of all databases that can be accessed by this user with the access level
+	// of each database.
+	AccessibleDatabases(ctx context.Context) (map[string]Grant, error)
+
+	// AccessibleDatabasesFull returns a map of all databases that can be accessed by this user,
+	// including the access level of each collection.
+	AccessibleDatabasesFull(ctx context.Context) (map[string]DatabasePermissions, error)
+
+	// GetDatabaseAccess gets the access rights for this user to the given database.
+	// Pass a `*` database to get the default access this user has to any new database.
+	GetDatabaseAccess(ctx context.Context, db string) (Grant, error)
+
+	// SetDatabaseAccess sets the access this user has to the given database.
+	// Pass a `*` database to set the default access this user has to any new database.
+	SetDatabaseAccess(ctx context.Context, db string, access Grant) error
+
+	// RemoveDatabaseAccess removes the access this user has to the given database.
+	// As a result the users access falls back to its default access.
+	// If you remove default access (db=`*`) for a user (and there are no specific access
+	// rules for a database), the user's access falls back to no-access.
+	RemoveDatabaseAccess(ctx context.Context, db string) error
+
+	// GetCollectionAccess gets the access rights for this user to the given collection.
+	// Pass a `*` collection to get the default collection access for the given database.
+	GetCollectionAccess(ctx context.Context, db, col string) (Grant, error)
+
+	// SetCollectionAccess sets the access this user has to the given collection.
+	// Pass a `*` collection to set the default collection access for the given database.
+	// Pass a `*` database and a `*` collection to set the default collection access for any database.
+	SetCollectionAccess(ctx context.Context, db, col string, access Grant) error
+
+	// RemoveCollectionAccess removes the access this user has to the given collection.
+	// As a result the users access falls back to its default access.
+	RemoveCollectionAccess(ctx context.Context, db, col string) error
+}
+
+// Grant specifies access rights for an object
+type Grant string
+
+const (
+	// GrantReadWrite indicates read/write access to an object
+	GrantReadWrite Grant = "rw"
+	// GrantReadOnly indicates read-only access to an object
+	GrantReadOnly Grant = "ro"
+	// GrantNone indicates no access to an object
+	GrantNone Grant = "none"
+	// GrantUndefined indicates that the access level is not set for an object
+	GrantUndefined Grant = "undefined"
+)
+
+// GrantWildcard is used instead of a database or collection name to refer to the default access level.
+const GrantWildcard = "*"
+
+// DatabasePermissions contains the access level of a database and its collections.
+type DatabasePermissions struct {
+	// Permission is the access level of the database.
+	Permission Grant `json:"permission,omitempty"`
+	// Collections contains the access level of each collection in the database.
+	Collections map[string]Grant `json:"collections,omitempty"`
+}
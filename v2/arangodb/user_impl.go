@@ -0,0 +1,206 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangodb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func newUser(client *client, data userData) *user {
+	return &user{
+		client: client,
+		data:   data,
+	}
+}
+
+var _ User = &user{}
+
+type user struct {
+	client *client
+
+	data userData
+}
+
+type userData struct {
+	Name           string       `json:"user,omitempty"`
+	Active         bool         `json:"active,omitempty"`
+	Extra          *byteDecoder `json:"extra,omitempty"`
+	ChangePassword bool         `json:"changePassword,omitempty"`
+}
+
+func (u *user) Name() string {
+	return u.data.Name
+}
+
+func (u *user) IsActive() bool {
+	return u.data.Active
+}
+
+func (u *user) IsPasswordChangeNeeded() bool {
+	return u.data.ChangePassword
+}
+
+func (u *user) Extra(result interface{}) error {
+	if u.data.Extra == nil {
+		return nil
+	}
+
+	return errors.WithStack(u.data.Extra.Unmarshal(result))
+}
+
+func (u *user) AccessibleDatabases(ctx context.Context) (map[string]Grant, error) {
+	var result map[string]Grant
+
+	if err := u.accessibleDatabases(ctx, false, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (u *user) AccessibleDatabasesFull(ctx context.Context) (map[string]DatabasePermissions, error) {
+	var result map[string]DatabasePermissions
+
+	if err := u.accessibleDatabases(ctx, true, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (u *user) accessibleDatabases(ctx context.Context, full bool, result interface{}) error {
+	url := u.url("database")
+
+	response := struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                *UnmarshalInto `json:"result,omitempty"`
+	}{
+		Result: newUnmarshalInto(result),
+	}
+
+	resp, err := connection.CallGet(ctx, u.client.connection, url, &response, connection.WithQuery("full", boolToString(full)))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (u *user) GetDatabaseAccess(ctx context.Context, db string) (Grant, error) {
+	return u.getAccess(ctx, u.url("database", db))
+}
+
+func (u *user) SetDatabaseAccess(ctx context.Context, db string, access Grant) error {
+	return u.setAccess(ctx, u.url("database", db), access)
+}
+
+func (u *user) RemoveDatabaseAccess(ctx context.Context, db string) error {
+	return u.removeAccess(ctx, u.url("database", db))
+}
+
+func (u *user) GetCollectionAccess(ctx context.Context, db, col string) (Grant, error) {
+	return u.getAccess(ctx, u.url("database", db, col))
+}
+
+func (u *user) SetCollectionAccess(ctx context.Context, db, col string, access Grant) error {
+	return u.setAccess(ctx, u.url("database", db, col), access)
+}
+
+func (u *user) RemoveCollectionAccess(ctx context.Context, db, col string) error {
+	return u.removeAccess(ctx, u.url("database", db, col))
+}
+
+func (u *user) getAccess(ctx context.Context, url string) (Grant, error) {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Result                Grant `json:"result"`
+	}
+
+	resp, err := connection.CallGet(ctx, u.client.connection, url, &response)
+	if err != nil {
+		return GrantNone, errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return response.Result, nil
+	default:
+		return GrantNone, response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (u *user) setAccess(ctx context.Context, url string, access Grant) error {
+	input := struct {
+		Grant Grant `json:"grant"`
+	}{
+		Grant: access,
+	}
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallPut(ctx, u.client.connection, url, &response, &input)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+func (u *user) removeAccess(ctx context.Context, url string) error {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+	}
+
+	resp, err := connection.CallDelete(ctx, u.client.connection, url, &response)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK, http.StatusAccepted:
+		return nil
+	default:
+		return response.AsArangoErrorWithCode(code)
+	}
+}
+
+// url returns the path to this user (`_db/_system/_api/user/<name>/<parts>`)
+func (u *user) url(parts ...string) string {
+	return connection.NewUrl(append([]string{"_db", "_system", "_api", "user", u.data.Name}, parts...)...)
+}
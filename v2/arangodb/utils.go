@@ -28,6 +28,8 @@ import (
 	"reflect"
 
 	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 var _ json.Unmarshaler = &multiUnmarshaller{}
@@ -89,6 +91,18 @@ func (b *byteDecoder) Unmarshal(i interface{}) error {
 	return json.Unmarshal(b.data, i)
 }
 
+// AsArangoErrorWithCode returns an ArangoError built from the error object stored in the decoder.
+// Fields which can not be decoded are ignored, so the returned error always contains at least the given code.
+func (b *byteDecoder) AsArangoErrorWithCode(code int) shared.ArangoError {
+	var response shared.ResponseStruct
+
+	if len(b.data) > 0 {
+		_ = b.Unmarshal(&response)
+	}
+
+	return response.AsArangoErrorWithCode(code)
+}
+
 func newUnmarshalInto(obj interface{}) *UnmarshalInto {
 	return &UnmarshalInto{obj}
 }
@@ -42,10 +42,26 @@ func CallWithChecks(ctx context.Context, c Connection, method, url string, outpu
 		return nil, err
 	}
 
+	return call(ctx, c, req, output, allowedStatusCodes, modifiers...)
+}
+
+// CallWithEndpoint performs HTTP request with the given method and URL on the given endpoint.
+// It is used for requests which must be handled by a specific server, e.g. reading the next batch of a cursor
+// from the coordinator which owns it. If the endpoint is not known by the connection, another one is chosen.
+func CallWithEndpoint(ctx context.Context, c Connection, endpoint, method, url string, output interface{}, modifiers ...RequestModifier) (Response, error) {
+	req, err := c.NewRequestWithEndpoint(endpoint, method, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return call(ctx, c, req, output, nil, modifiers...)
+}
+
+func call(ctx context.Context, c Connection, req Request, output interface{}, allowedStatusCodes []int, modifiers ...RequestModifier) (Response, error) {
 	modifiers = append(modifiers, applyGlobalSettings(ctx))
 
 	for _, modifier := range modifiers {
-		if err = modifier(req); err != nil {
+		if err := modifier(req); err != nil {
 			return nil, err
 		}
 	}
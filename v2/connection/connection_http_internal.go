@@ -35,7 +35,6 @@ import (
 	"github.com/pkg/errors"
 	_ "golang.org/x/net/http2"
 
-	"github.com/arangodb/go-driver/v2/arangodb/shared"
 	"github.com/arangodb/go-driver/v2/log"
 )
 
@@ -153,6 +152,7 @@ func (j *httpConnection) newRequestWithEndpoint(endpoint string, method string,
 		method:   method,
 		url:      u,
 		endpoint: e,
+		pinned:   endpoint != "",
 	}
 
 	return r, nil
@@ -161,40 +161,7 @@ func (j *httpConnection) newRequestWithEndpoint(endpoint string, method string,
 // Do performs HTTP request and returns the response.
 // If `output` is provided then it is populated from response body and the response is automatically freed.
 func (j *httpConnection) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
-	resp, body, err := j.Stream(ctx, request)
-	if err != nil {
-		return resp, err
-	}
-
-	// The body should be closed at the end of the function.
-	defer dropBodyData(body)
-
-	if len(allowedStatusCodes) > 0 {
-		found := false
-		for _, e := range allowedStatusCodes {
-			if resp.Code() == e {
-				found = true
-				break
-			}
-		}
-		if !found {
-			var respStruct shared.Response
-			// try parse as ArangoDB error response
-			_ = j.Decoder(resp.Content()).Decode(body, &respStruct)
-			return resp, respStruct.AsArangoErrorWithCode(resp.Code())
-		}
-	}
-
-	if output != nil {
-		// The output should be stored in the output variable.
-		if err = j.Decoder(resp.Content()).Decode(body, output); err != nil {
-			if err != io.EOF {
-				return resp, errors.WithStack(err)
-			}
-		}
-	}
-
-	return resp, nil
+	return doWithStream(ctx, j, request, output, allowedStatusCodes...)
 }
 
 // Stream performs HTTP request.
@@ -233,7 +200,10 @@ func (j *httpConnection) stream(ctx context.Context, req *httpRequest) (*httpRes
 		ctx = context.Background()
 	}
 
-	reader := j.bodyReadFunc(j.Decoder(j.contentType), req.body, j.streamSender)
+	// The body is encoded according to the content type of the request, so binary payloads (e.g. zip archives)
+	// can be sent as they are.
+	contentType, _ := req.GetHeader(ContentType)
+	reader := j.bodyReadFunc(j.Decoder(contentType), req.body, j.streamSender)
 	r, err := req.asRequest(ctx, reader)
 	if err != nil {
 		return nil, nil, errors.WithStack(err)
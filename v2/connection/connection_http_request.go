@@ -37,6 +37,8 @@ type httpRequest struct {
 	url *url.URL
 
 	endpoint string
+	// pinned is true when the endpoint has been provided when the request has been created.
+	pinned bool
 
 	body interface{}
 
@@ -0,0 +1,89 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"crypto/tls"
+	"time"
+)
+
+// VstConfiguration is the configuration of a connection which uses the VelocyStream protocol, version 1.1.
+type VstConfiguration struct {
+	// Authentication is sent to the server as an authentication message, once for each TCP connection.
+	// Basic authentication (NewBasicAuth) and JWT authentication (NewJWTAuthWrapper or an "Authorization"
+	// header with a bearer token) are supported.
+	Authentication Authentication
+	Endpoint       Endpoint
+
+	// TLSConfig is used for endpoints with the "https" or "ssl" scheme.
+	TLSConfig *tls.Config
+
+	// MaxChunkSize is the maximum size of a chunk of a request message, including the chunk header.
+	// The default is 30000 bytes.
+	MaxChunkSize uint32
+
+	// DialTimeout is the timeout for opening a TCP connection. The default is 30 seconds.
+	DialTimeout time.Duration
+
+	// IdleTimeout is the time after which a TCP connection without pending requests is closed.
+	// The default is 60 seconds. The TCP connections are not closed when they are idle, if it is negative.
+	IdleTimeout time.Duration
+}
+
+func (v VstConfiguration) getMaxChunkSize() uint32 {
+	if v.MaxChunkSize == 0 {
+		return vstDefaultMaxChunkSize
+	}
+
+	return v.MaxChunkSize
+}
+
+func (v VstConfiguration) getDialTimeout() time.Duration {
+	if v.DialTimeout == 0 {
+		return 30 * time.Second
+	}
+
+	return v.DialTimeout
+}
+
+func (v VstConfiguration) getIdleTimeout() time.Duration {
+	if v.IdleTimeout == 0 {
+		return 60 * time.Second
+	}
+
+	return v.IdleTimeout
+}
+
+// NewVstConnection returns a connection which uses the VelocyStream protocol, version 1.1.
+// All requests to an endpoint are multiplexed over a single TCP (or TLS) connection, which is opened
+// when the first request is sent, and opened again when it is closed, e.g. by the server after an idle timeout.
+// The request bodies are encoded with VelocyPack, except for binary content types, e.g. text/plain or application/zip.
+// The returned connection implements io.Closer, which closes its TCP connections when it is not used anymore.
+func NewVstConnection(config VstConfiguration) Connection {
+	c := newVstConnection(config.Endpoint, config.TLSConfig, config.getMaxChunkSize(), config.getDialTimeout(),
+		config.getIdleTimeout())
+
+	if a := config.Authentication; a != nil {
+		_ = c.SetAuthentication(a)
+	}
+
+	return c
+}
@@ -0,0 +1,480 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arangodb/go-velocypack"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/log"
+)
+
+const (
+	vstMessageTypeRequest        = 1
+	vstMessageTypeAuthentication = 1000
+)
+
+// vstRequestTypes maps the HTTP methods to the VST request types.
+var vstRequestTypes = map[string]int{
+	http.MethodDelete:  0,
+	http.MethodGet:     1,
+	http.MethodPost:    2,
+	http.MethodPut:     3,
+	http.MethodHead:    4,
+	http.MethodPatch:   5,
+	http.MethodOptions: 6,
+}
+
+func newVstConnection(endpoint Endpoint, tlsConfig *tls.Config, maxChunkSize uint32, dialTimeout,
+	idleTimeout time.Duration) *vstConnection {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &vstConnection{
+		ctx:          ctx,
+		cancel:       cancel,
+		endpoint:     endpoint,
+		tlsConfig:    tlsConfig,
+		maxChunkSize: maxChunkSize,
+		dialTimeout:  dialTimeout,
+		idleTimeout:  idleTimeout,
+		sockets:      map[string]*vstSocket{},
+		dials:        map[string]*vstDial{},
+	}
+}
+
+type vstConnection struct {
+	// ctx is canceled when the connection is closed, so the sockets which are being opened are abandoned.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	endpoint       Endpoint
+	authentication Authentication
+
+	tlsConfig    *tls.Config
+	maxChunkSize uint32
+	dialTimeout  time.Duration
+	idleTimeout  time.Duration
+
+	lock    sync.Mutex
+	sockets map[string]*vstSocket
+	// dials are the sockets which are being opened, so the lock is not held while dialing.
+	dials  map[string]*vstDial
+	closed bool
+}
+
+// vstDial is the opening of a socket, which is awaited by all requests to the endpoint.
+type vstDial struct {
+	done   chan struct{}
+	socket *vstSocket
+	err    error
+}
+
+func (v *vstConnection) GetAuthentication() Authentication {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	return v.authentication
+}
+
+// SetAuthentication changes the authentication of the connection.
+// Each socket is authenticated again before it sends the next request.
+func (v *vstConnection) SetAuthentication(a Authentication) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.authentication = a
+	return nil
+}
+
+// Decoder returns the decoder according to the response content type.
+// If the content type is unknown then it returns default VelocyPack decoder.
+func (v *vstConnection) Decoder(contentType string) Decoder {
+	if decoder := getDecoderByContentType(contentType); decoder != nil {
+		return decoder
+	}
+
+	return getVPackDecoder()
+}
+
+func (v *vstConnection) GetEndpoint() Endpoint {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	return v.endpoint
+}
+
+// SetEndpoint changes the endpoint and closes the sockets to the servers which are not in the new endpoint.
+func (v *vstConnection) SetEndpoint(e Endpoint) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.endpoint = e
+
+	known := map[string]bool{}
+	for _, address := range e.List() {
+		known[address] = true
+	}
+
+	for address, socket := range v.sockets {
+		if !known[address] {
+			socket.close(errVstSocketClosed)
+			delete(v.sockets, address)
+		}
+	}
+
+	return nil
+}
+
+// Close closes the TCP connections. The pending requests fail, and no more requests can be sent.
+func (v *vstConnection) Close() error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.closed = true
+	v.cancel()
+	for address, socket := range v.sockets {
+		socket.close(errVstSocketClosed)
+		delete(v.sockets, address)
+	}
+
+	return nil
+}
+
+func (v *vstConnection) NewRequestWithEndpoint(endpoint string, method string, urlParts ...string) (Request, error) {
+	return v.newRequestWithEndpoint(endpoint, method, urlParts...)
+}
+
+func (v *vstConnection) NewRequest(method string, urlParts ...string) (Request, error) {
+	return v.newRequestWithEndpoint("", method, urlParts...)
+}
+
+func (v *vstConnection) newRequestWithEndpoint(endpoint string, method string, urlParts ...string) (*httpRequest, error) {
+	urlPath := path.Join(urlParts...)
+
+	e, err := v.GetEndpoint().Get(endpoint, method, urlPath)
+	if err != nil {
+		return nil, errors.Errorf("Unable to resolve endpoint for %s", endpoint)
+	}
+	u, err := url.Parse(e)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path.Join(u.Path, urlPath)
+
+	r := &httpRequest{
+		method:   method,
+		url:      u,
+		endpoint: e,
+		pinned:   endpoint != "",
+	}
+
+	return r, nil
+}
+
+// Do performs VST request and returns the response.
+// If `output` is provided then it is populated from response body and the response is automatically freed.
+func (v *vstConnection) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	return doWithStream(ctx, v, request, output, allowedStatusCodes...)
+}
+
+// Stream performs VST request.
+// It returns the response and body reader to read the data from there.
+// The whole response is received before it returns, so the body reader does not need to be closed.
+func (v *vstConnection) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	req, ok := request.(*httpRequest)
+	if !ok {
+		return nil, nil, errors.Errorf("unable to parse request into VST Request")
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	id := uuid.New().String()
+	log.Debugf("(%s) Sending VST request to %s/%s", id, req.Method(), req.URL())
+
+	if value, ok := req.GetHeader(ContentType); !ok || value == "" {
+		req.AddHeader(ContentType, ApplicationVPack)
+	}
+	if value, ok := req.GetHeader("Accept"); !ok || value == "" {
+		req.AddHeader("Accept", ApplicationVPack)
+	}
+
+	if auth := v.GetAuthentication(); auth != nil {
+		if err := auth.RequestModifier(req); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+	}
+
+	// The authorization is sent once for each socket in the authentication message.
+	authorization, _ := req.GetHeader("Authorization")
+	delete(req.headers, "Authorization")
+
+	message, err := v.requestMessage(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data []byte
+	for attempt := 1; ; attempt++ {
+		socket, err := v.socket(ctx, req.Endpoint())
+		if err != nil {
+			log.Debugf("(%s) Connection failed: %s", id, err.Error())
+			return nil, nil, err
+		}
+
+		err = v.authenticate(ctx, socket, authorization)
+		if err == nil {
+			data, err = socket.send(ctx, message...)
+		}
+
+		if errors.Is(err, errVstSocketNotOpen) && attempt == 1 {
+			// The socket has been closed before the request was sent, e.g. after the idle timeout.
+			continue
+		}
+
+		if err != nil {
+			log.Debugf("(%s) Request failed: %s", id, err.Error())
+			return nil, nil, err
+		}
+
+		break
+	}
+
+	resp, body, err := newVstResponse(req, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Debugf("(%s) Response received: %d", id, resp.Code())
+
+	return resp, io.NopCloser(bytes.NewReader(body)), nil
+}
+
+// requestMessage returns the header and the body of the VST request message.
+func (v *vstConnection) requestMessage(req *httpRequest) ([][]byte, error) {
+	requestType, ok := vstRequestTypes[req.Method()]
+	if !ok {
+		return nil, errors.Errorf("method %s is not supported by VST", req.Method())
+	}
+
+	database := "_system"
+	urlPath := strings.TrimPrefix(req.url.EscapedPath(), "/")
+	if strings.HasPrefix(urlPath, "_db/") {
+		parts := strings.SplitN(strings.TrimPrefix(urlPath, "_db/"), "/", 2)
+		name, err := url.PathUnescape(parts[0])
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		database = name
+		urlPath = ""
+		if len(parts) > 1 {
+			urlPath = parts[1]
+		}
+	}
+
+	parameters := map[string]string{}
+	for key, values := range req.url.Query() {
+		if len(values) > 1 {
+			// The parameters of the VST message are an object, so each parameter can have only one value.
+			return nil, errors.Errorf("query parameter %s with multiple values is not supported by VST", key)
+		}
+
+		if len(values) > 0 {
+			parameters[key] = values[0]
+		}
+	}
+
+	meta := map[string]string{}
+	for key, value := range req.headers {
+		meta[key] = value
+	}
+
+	header, err := velocypack.Marshal([]interface{}{
+		1, vstMessageTypeRequest, database, requestType, "/" + urlPath, parameters, meta,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if req.body == nil {
+		return [][]byte{header}, nil
+	}
+
+	// The body is encoded according to the content type of the request, so binary payloads (e.g. zip archives)
+	// can be sent as they are.
+	contentType, _ := req.GetHeader(ContentType)
+	body := bytes.NewBuffer([]byte{})
+	if err := v.Decoder(contentType).Encode(body, req.body); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return [][]byte{header, body.Bytes()}, nil
+}
+
+// socket returns the socket to the server, and opens a new one when it is not open.
+// The socket is opened without holding the lock, so the other requests are not blocked by an unreachable server.
+func (v *vstConnection) socket(ctx context.Context, endpoint string) (*vstSocket, error) {
+	v.lock.Lock()
+	if v.closed {
+		v.lock.Unlock()
+		return nil, errors.WithStack(errVstSocketClosed)
+	}
+
+	if socket, ok := v.sockets[endpoint]; ok && !socket.isClosed() {
+		v.lock.Unlock()
+		return socket, nil
+	}
+
+	dial, ok := v.dials[endpoint]
+	if !ok {
+		dial = &vstDial{done: make(chan struct{})}
+		v.dials[endpoint] = dial
+
+		// The socket is shared by the requests, so it is opened with the context of the connection.
+		go v.dial(endpoint, dial)
+	}
+	v.lock.Unlock()
+
+	select {
+	case <-dial.done:
+		return dial.socket, dial.err
+	case <-contextOrBackground(ctx).Done():
+		return nil, errors.WithStack(ctx.Err())
+	}
+}
+
+// dial opens the socket to the server and makes it available for the requests.
+func (v *vstConnection) dial(endpoint string, dial *vstDial) {
+	defer close(dial.done)
+
+	dial.socket, dial.err = v.dialSocket(endpoint)
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	delete(v.dials, endpoint)
+	if dial.err != nil {
+		return
+	}
+
+	if v.closed {
+		dial.socket.close(errVstSocketClosed)
+		dial.socket, dial.err = nil, errors.WithStack(errVstSocketClosed)
+		return
+	}
+
+	v.sockets[endpoint] = dial.socket
+}
+
+func (v *vstConnection) dialSocket(endpoint string) (*vstSocket, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var tlsConfig *tls.Config
+	switch strings.ToLower(u.Scheme) {
+	case "https", "ssl":
+		tlsConfig = v.tlsConfig
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+	}
+
+	return dialVstSocket(v.ctx, u.Host, tlsConfig, v.maxChunkSize, v.dialTimeout, v.idleTimeout)
+}
+
+// authenticate sends the authentication message when the socket is not authenticated with the given authorization.
+func (v *vstConnection) authenticate(ctx context.Context, socket *vstSocket, authorization string) error {
+	socket.authLock.Lock()
+	defer socket.authLock.Unlock()
+
+	if authorization == "" || socket.authorization == authorization {
+		return nil
+	}
+
+	message, err := vstAuthenticationMessage(authorization)
+	if err != nil {
+		return err
+	}
+
+	data, err := socket.send(ctx, message)
+	if err != nil {
+		return err
+	}
+
+	resp, body, err := newVstResponse(nil, data)
+	if err != nil {
+		return err
+	}
+
+	if resp.Code() != http.StatusOK {
+		var respStruct shared.Response
+		_ = v.Decoder(resp.Content()).Decode(bytes.NewReader(body), &respStruct)
+		return respStruct.AsArangoErrorWithCode(resp.Code())
+	}
+
+	socket.authorization = authorization
+	return nil
+}
+
+// vstAuthenticationMessage converts the value of the "Authorization" header into the VST authentication message.
+func vstAuthenticationMessage(authorization string) ([]byte, error) {
+	scheme, credentials, _ := strings.Cut(authorization, " ")
+
+	var message []interface{}
+	switch strings.ToLower(scheme) {
+	case "basic":
+		decoded, err := base64.StdEncoding.DecodeString(credentials)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		username, password, _ := strings.Cut(string(decoded), ":")
+		message = []interface{}{1, vstMessageTypeAuthentication, "plain", username, password}
+	case "bearer":
+		message = []interface{}{1, vstMessageTypeAuthentication, "jwt", credentials}
+	default:
+		return nil, errors.Errorf("authorization scheme '%s' is not supported by VST", scheme)
+	}
+
+	data, err := velocypack.Marshal(message)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,414 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	vstChunkHeaderSize     = 24
+	vstDefaultMaxChunkSize = 30000
+)
+
+var vstProtocolHeader = []byte("VST/1.1\r\n\r\n")
+
+// errVstSocketClosed is returned for the requests which are pending when the socket is closed.
+var errVstSocketClosed = errors.New("VST connection closed")
+
+// errVstSocketNotOpen is returned for the requests which are not sent, because the socket has been closed before.
+var errVstSocketNotOpen = errors.New("VST connection is not open")
+
+// vstChunk is a part of a VST message.
+type vstChunk struct {
+	// chunkX holds the number of chunks of the message in the first chunk, and the index of the chunk in the other chunks.
+	chunkX        uint32
+	messageID     uint64
+	messageLength uint64
+	data          []byte
+}
+
+// isFirst returns true for the first chunk of a message.
+func (c vstChunk) isFirst() bool {
+	return c.chunkX&0x01 == 1
+}
+
+// index returns the index of the chunk in the message.
+func (c vstChunk) index() uint32 {
+	if c.isFirst() {
+		return 0
+	}
+	return c.chunkX >> 1
+}
+
+// numberOfChunks returns the number of chunks of the message. It is known only from the first chunk.
+func (c vstChunk) numberOfChunks() uint32 {
+	if c.isFirst() {
+		return c.chunkX >> 1
+	}
+	return 0
+}
+
+// writeTo writes the chunk in the VST 1.1 format.
+func (c vstChunk) writeTo(w io.Writer) error {
+	var header [vstChunkHeaderSize]byte
+
+	le := binary.LittleEndian
+	le.PutUint32(header[0:], uint32(len(c.data)+vstChunkHeaderSize))
+	le.PutUint32(header[4:], c.chunkX)
+	le.PutUint64(header[8:], c.messageID)
+	le.PutUint64(header[16:], c.messageLength)
+
+	if _, err := w.Write(header[:]); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := w.Write(c.data); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// readVstChunk reads a chunk in the VST 1.1 format.
+func readVstChunk(r io.Reader) (vstChunk, error) {
+	var header [vstChunkHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return vstChunk{}, errors.WithStack(err)
+	}
+
+	le := binary.LittleEndian
+	length := le.Uint32(header[0:])
+	if length < vstChunkHeaderSize {
+		return vstChunk{}, errors.Errorf("invalid VST chunk length %d", length)
+	}
+
+	c := vstChunk{
+		chunkX:        le.Uint32(header[4:]),
+		messageID:     le.Uint64(header[8:]),
+		messageLength: le.Uint64(header[16:]),
+		data:          make([]byte, length-vstChunkHeaderSize),
+	}
+	if _, err := io.ReadFull(r, c.data); err != nil {
+		return vstChunk{}, errors.WithStack(err)
+	}
+
+	return c, nil
+}
+
+// buildVstChunks splits the message, which consists of the given parts, into chunks of at most maxChunkSize bytes.
+func buildVstChunks(messageID uint64, maxChunkSize uint32, parts ...[]byte) ([]vstChunk, error) {
+	if maxChunkSize <= vstChunkHeaderSize {
+		return nil, errors.Errorf("max chunk size %d is too small", maxChunkSize)
+	}
+
+	messageLength := uint64(0)
+	for _, part := range parts {
+		messageLength += uint64(len(part))
+	}
+
+	maxDataLength := int(maxChunkSize - vstChunkHeaderSize)
+	chunks := make([]vstChunk, 0, int(messageLength)/maxDataLength+len(parts))
+	for _, part := range parts {
+		for offset := 0; offset < len(part); offset += maxDataLength {
+			end := offset + maxDataLength
+			if end > len(part) {
+				end = len(part)
+			}
+
+			chunks = append(chunks, vstChunk{
+				chunkX:        uint32(len(chunks)) << 1,
+				messageID:     messageID,
+				messageLength: messageLength,
+				data:          part[offset:end],
+			})
+		}
+	}
+
+	if len(chunks) == 0 {
+		return nil, errors.New("VST message is empty")
+	}
+	chunks[0].chunkX = uint32(len(chunks))<<1 | 0x01
+
+	return chunks, nil
+}
+
+// vstMessage collects the chunks of a received message.
+type vstMessage struct {
+	chunks         []vstChunk
+	numberOfChunks uint32
+}
+
+// add adds the chunk to the message and returns the data of the message when all chunks are received.
+func (m *vstMessage) add(c vstChunk) ([]byte, bool) {
+	m.chunks = append(m.chunks, c)
+	if c.isFirst() {
+		m.numberOfChunks = c.numberOfChunks()
+	}
+
+	if m.numberOfChunks == 0 || len(m.chunks) < int(m.numberOfChunks) {
+		return nil, false
+	}
+
+	if len(m.chunks) == 1 {
+		return m.chunks[0].data, true
+	}
+
+	sort.Slice(m.chunks, func(i, j int) bool {
+		return m.chunks[i].index() < m.chunks[j].index()
+	})
+
+	data := make([]byte, 0, m.chunks[0].messageLength)
+	for _, chunk := range m.chunks {
+		data = append(data, chunk.data...)
+	}
+
+	return data, true
+}
+
+// vstResult is the result of a request sent over a VST socket.
+type vstResult struct {
+	data []byte
+	err  error
+}
+
+// vstPending is a request which waits for its response.
+type vstPending struct {
+	message vstMessage
+	result  chan vstResult
+}
+
+// vstSocket is a single TCP connection to a server, over which the requests are multiplexed.
+type vstSocket struct {
+	conn         net.Conn
+	maxChunkSize uint32
+
+	lastMessageID uint64
+	writeLock     sync.Mutex
+
+	lock    sync.Mutex
+	pending map[uint64]*vstPending
+	closed  bool
+
+	// idleTimer closes the socket when there are no pending requests for idleTimeout.
+	idleTimer   *time.Timer
+	idleTimeout time.Duration
+
+	// authLock serializes the authentication of the socket.
+	authLock sync.Mutex
+	// authorization is the value of the "Authorization" header which the socket is authenticated with.
+	authorization string
+}
+
+// dialVstSocket opens a TCP connection to the address and starts reading the responses.
+func dialVstSocket(ctx context.Context, address string, tlsConfig *tls.Config, maxChunkSize uint32,
+	dialTimeout, idleTimeout time.Duration) (*vstSocket, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout, KeepAlive: 30 * time.Second}
+
+	var conn net.Conn
+	var err error
+	if tlsConfig != nil {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		tcpConn.SetNoDelay(true)
+	}
+
+	if _, err := conn.Write(vstProtocolHeader); err != nil {
+		conn.Close()
+		return nil, errors.WithStack(err)
+	}
+
+	s := &vstSocket{
+		conn:         conn,
+		maxChunkSize: maxChunkSize,
+		pending:      map[uint64]*vstPending{},
+		idleTimeout:  idleTimeout,
+	}
+	if idleTimeout > 0 {
+		s.idleTimer = time.AfterFunc(idleTimeout, s.closeIdle)
+	}
+	go s.readLoop()
+
+	return s, nil
+}
+
+// send sends the message, which consists of the given parts, and waits for the response.
+func (s *vstSocket) send(ctx context.Context, parts ...[]byte) ([]byte, error) {
+	id := atomic.AddUint64(&s.lastMessageID, 1)
+
+	chunks, err := buildVstChunks(id, s.maxChunkSize, parts...)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := &vstPending{result: make(chan vstResult, 1)}
+
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return nil, errors.WithStack(errVstSocketNotOpen)
+	}
+	s.pending[id] = pending
+	if s.idleTimer != nil {
+		s.idleTimer.Stop()
+	}
+	s.lock.Unlock()
+
+	if err := s.write(ctx, chunks); err != nil {
+		// The chunks may be written partially, so the socket can not be used anymore.
+		s.close(err)
+		return nil, err
+	}
+
+	select {
+	case result := <-pending.result:
+		return result.data, result.err
+	case <-ctx.Done():
+		s.lock.Lock()
+		delete(s.pending, id)
+		s.resetIdleTimer()
+		s.lock.Unlock()
+
+		return nil, errors.WithStack(ctx.Err())
+	}
+}
+
+// write writes all chunks of a message, so they are not interleaved with the chunks of other messages.
+func (s *vstSocket) write(ctx context.Context, chunks []vstChunk) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	deadline, _ := ctx.Deadline()
+	if err := s.conn.SetWriteDeadline(deadline); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, chunk := range chunks {
+		if err := chunk.writeTo(s.conn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readLoop reads the chunks and delivers the messages to the pending requests, until the socket is closed.
+func (s *vstSocket) readLoop() {
+	for {
+		chunk, err := readVstChunk(s.conn)
+		if err != nil {
+			s.close(err)
+			return
+		}
+
+		s.lock.Lock()
+		pending, ok := s.pending[chunk.messageID]
+		if !ok {
+			// The request has been canceled.
+			s.lock.Unlock()
+			continue
+		}
+
+		data, complete := pending.message.add(chunk)
+		if complete {
+			delete(s.pending, chunk.messageID)
+			s.resetIdleTimer()
+		}
+		s.lock.Unlock()
+
+		if complete {
+			pending.result <- vstResult{data: data}
+		}
+	}
+}
+
+// close closes the TCP connection and fails all pending requests.
+func (s *vstSocket) close(cause error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.closeLocked(cause)
+}
+
+// closeLocked closes the socket. The lock must be held.
+func (s *vstSocket) closeLocked(cause error) {
+	if s.closed {
+		return
+	}
+	s.closed = true
+	s.conn.Close()
+	if s.idleTimer != nil {
+		s.idleTimer.Stop()
+	}
+
+	err := cause
+	if errors.Is(cause, io.EOF) {
+		// The connection is closed by the server while the requests are pending.
+		err = errors.WithStack(io.ErrUnexpectedEOF)
+	}
+
+	for id, pending := range s.pending {
+		pending.result <- vstResult{err: err}
+		delete(s.pending, id)
+	}
+}
+
+// isClosed returns true when the socket can not be used anymore.
+func (s *vstSocket) isClosed() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.closed
+}
+
+// resetIdleTimer starts the idle timeout when there are no pending requests. The lock must be held.
+func (s *vstSocket) resetIdleTimer() {
+	if s.idleTimer != nil && !s.closed && len(s.pending) == 0 {
+		s.idleTimer.Reset(s.idleTimeout)
+	}
+}
+
+// closeIdle closes the socket when there are still no pending requests.
+func (s *vstSocket) closeIdle() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.pending) == 0 {
+		s.closeLocked(errVstSocketClosed)
+	}
+}
@@ -0,0 +1,107 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"strings"
+
+	"github.com/arangodb/go-velocypack"
+	"github.com/pkg/errors"
+)
+
+type vstResponse struct {
+	request *httpRequest
+
+	code int
+	meta map[string]string
+}
+
+// newVstResponse decodes the header of the VST response message, and returns the response and the body of the message.
+func newVstResponse(req *httpRequest, data []byte) (*vstResponse, []byte, error) {
+	header := velocypack.Slice(data)
+	size, err := header.ByteSize()
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	if int(size) > len(data) {
+		return nil, nil, errors.Errorf("invalid VST response header size %d", size)
+	}
+
+	var fields []velocypack.RawSlice
+	if err := velocypack.Unmarshal(header[:size], &fields); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	if len(fields) < 3 {
+		return nil, nil, errors.Errorf("expected a VST response header of at least 3 elements, got %d", len(fields))
+	}
+
+	resp := &vstResponse{
+		request: req,
+		meta:    map[string]string{},
+	}
+	if err := velocypack.Unmarshal(velocypack.Slice(fields[2]), &resp.code); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	if len(fields) > 3 {
+		var meta map[string]string
+		if err := velocypack.Unmarshal(velocypack.Slice(fields[3]), &meta); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		for key, value := range meta {
+			resp.meta[strings.ToLower(key)] = value
+		}
+	}
+
+	return resp, data[size:], nil
+}
+
+func (v *vstResponse) Endpoint() string {
+	if v.request == nil {
+		return ""
+	}
+
+	return v.request.Endpoint()
+}
+
+// Response returns the meta fields of the VST response.
+func (v *vstResponse) Response() interface{} {
+	return v.meta
+}
+
+func (v *vstResponse) Code() int {
+	return v.code
+}
+
+// Content returns the content type of the response. The default content type of the VST responses is VelocyPack.
+func (v *vstResponse) Content() string {
+	value := strings.Split(v.meta[ContentType], ";")
+	if len(value) > 0 && value[0] != "" {
+		return strings.TrimSpace(value[0])
+	}
+
+	return ApplicationVPack
+}
+
+func (v *vstResponse) Header(name string) string {
+	return v.meta[strings.ToLower(name)]
+}
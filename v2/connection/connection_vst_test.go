@@ -0,0 +1,486 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/arangodb/go-velocypack"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_buildVstChunks(t *testing.T) {
+	header := []byte("0123456789")
+	body := []byte("abcdefghijklmnopqrstuvwxyz")
+
+	chunks, err := buildVstChunks(7, vstChunkHeaderSize+8, header, body)
+	require.NoError(t, err)
+	// The header is split into 2 chunks and the body into 4 chunks.
+	require.Len(t, chunks, 6)
+	assert.True(t, chunks[0].isFirst())
+	assert.Equal(t, uint32(6), chunks[0].numberOfChunks())
+
+	var buffer bytes.Buffer
+	for _, chunk := range chunks {
+		require.NoError(t, chunk.writeTo(&buffer))
+	}
+
+	var read []vstChunk
+	for buffer.Len() > 0 {
+		chunk, err := readVstChunk(&buffer)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7), chunk.messageID)
+		assert.Equal(t, uint64(len(header)+len(body)), chunk.messageLength)
+		read = append(read, chunk)
+	}
+	require.Len(t, read, 6)
+
+	// The chunks can be received in any order.
+	var message vstMessage
+	for _, i := range []int{3, 5, 0, 1, 4} {
+		_, complete := message.add(read[i])
+		require.False(t, complete)
+	}
+	data, complete := message.add(read[2])
+	require.True(t, complete)
+	assert.Equal(t, "0123456789abcdefghijklmnopqrstuvwxyz", string(data))
+
+	_, err = buildVstChunks(1, vstChunkHeaderSize, header)
+	require.Error(t, err)
+}
+
+func Test_vstAuthenticationMessage(t *testing.T) {
+	data, err := vstAuthenticationMessage(authorizationValue(t, NewBasicAuth("root", "pass:word")))
+	require.NoError(t, err)
+
+	var message []interface{}
+	require.NoError(t, velocypack.Unmarshal(data, &message))
+	assert.Equal(t, []interface{}{1, vstMessageTypeAuthentication, "plain", "root", "pass:word"}, message)
+
+	data, err = vstAuthenticationMessage("bearer token")
+	require.NoError(t, err)
+
+	require.NoError(t, velocypack.Unmarshal(data, &message))
+	assert.Equal(t, []interface{}{1, vstMessageTypeAuthentication, "jwt", "token"}, message)
+
+	_, err = vstAuthenticationMessage("Digest abc")
+	require.Error(t, err)
+}
+
+// authorizationValue returns the value of the "Authorization" header set by the authentication.
+func authorizationValue(t *testing.T, a Authentication) string {
+	req := &httpRequest{}
+	require.NoError(t, a.RequestModifier(req))
+
+	value, _ := req.GetHeader("Authorization")
+	return value
+}
+
+func Test_vstConnection(t *testing.T) {
+	server := newVstTestServer(t, "root", "secret")
+
+	conn := NewVstConnection(VstConfiguration{
+		Endpoint:       NewRoundRobinEndpoints([]string{server.endpoint()}),
+		Authentication: NewBasicAuth("root", "secret"),
+		MaxChunkSize:   64,
+	})
+	defer conn.(io.Closer).Close()
+
+	t.Run("Do", func(t *testing.T) {
+		req, err := conn.NewRequest(http.MethodPost, "_db", "my db", "_api", "echo")
+		require.NoError(t, err)
+		req.AddQuery("waitForSync", "true")
+		require.NoError(t, req.SetBody(map[string]string{"name": "a long enough body to be split into many chunks"}))
+
+		var output vstTestEcho
+		resp, err := conn.Do(context.Background(), req, &output, http.StatusOK)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.Code())
+		assert.Equal(t, ApplicationVPack, resp.Content())
+		assert.Equal(t, "echo", resp.Header("X-Arango-Test"))
+		assert.Equal(t, server.endpoint(), resp.Endpoint())
+
+		assert.Equal(t, "my db", output.Database)
+		assert.Equal(t, int64(2), output.Type)
+		assert.Equal(t, "/_api/echo", output.Path)
+		assert.Equal(t, map[string]string{"waitForSync": "true"}, output.Parameters)
+		assert.Equal(t, "a long enough body to be split into many chunks", output.Body["name"])
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		resp, err := CallGet(context.Background(), conn, "_api/missing", nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.Code())
+
+		_, err = CallWithChecks(context.Background(), conn, http.MethodGet, "_api/missing", nil, []int{http.StatusOK})
+		require.Error(t, err)
+
+		arangoErr, ok := err.(shared.ArangoError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, arangoErr.Code)
+		assert.Equal(t, 1203, arangoErr.ErrorNum)
+	})
+
+	t.Run("requests are multiplexed", func(t *testing.T) {
+		slowDone := make(chan error, 1)
+		go func() {
+			_, err := CallGet(context.Background(), conn, "_api/slow", nil)
+			slowDone <- err
+		}()
+
+		// The fast request is answered while the slow request is pending on the same TCP connection.
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&server.slowPending) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		var output vstTestEcho
+		_, err := CallGet(context.Background(), conn, "_api/echo", &output)
+		require.NoError(t, err)
+		assert.Equal(t, "_system", output.Database)
+
+		close(server.releaseSlow)
+		require.NoError(t, <-slowDone)
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&server.connections))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&server.authentications))
+	})
+
+	t.Run("canceled request", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := CallGet(ctx, conn, "_api/hang", nil)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+		// The connection can still be used.
+		_, err = CallGet(context.Background(), conn, "_api/echo", nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("reconnect", func(t *testing.T) {
+		_, err := CallGet(context.Background(), conn, "_api/close", nil)
+		require.Error(t, err)
+		assert.True(t, IsNetworkError(err))
+
+		var output vstTestEcho
+		_, err = CallGet(context.Background(), conn, "_api/echo", &output)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&server.connections))
+		assert.Equal(t, int32(2), atomic.LoadInt32(&server.authentications))
+	})
+
+	t.Run("wrong credentials", func(t *testing.T) {
+		require.NoError(t, conn.SetAuthentication(NewBasicAuth("root", "wrong")))
+
+		_, err := CallGet(context.Background(), conn, "_api/echo", nil)
+		require.Error(t, err)
+
+		arangoErr, ok := err.(shared.ArangoError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, arangoErr.Code)
+	})
+}
+
+func Test_vstConnection_QueryParameters(t *testing.T) {
+	conn := NewVstConnection(VstConfiguration{Endpoint: NewRoundRobinEndpoints([]string{"http://127.0.0.1:1"})})
+
+	req, err := conn.NewRequest(http.MethodGet, "_api", "echo")
+	require.NoError(t, err)
+	req.AddQuery("key", "a")
+	req.AddQuery("key", "b")
+
+	// The request fails before it is sent.
+	_, _, err = conn.Stream(context.Background(), req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "query parameter key with multiple values")
+}
+
+func Test_vstConnection_Close(t *testing.T) {
+	server := newVstTestServer(t, "root", "secret")
+	goroutines := runtime.NumGoroutine()
+
+	conn := NewVstConnection(VstConfiguration{
+		Endpoint:       NewRoundRobinEndpoints([]string{server.endpoint()}),
+		Authentication: NewBasicAuth("root", "secret"),
+	})
+
+	_, err := CallGet(context.Background(), conn, "_api/echo", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, conn.(io.Closer).Close())
+
+	// The reading goroutines of the client and of the server end. The condition of
+	// require.Eventually runs on a goroutine of its own, so poll here instead.
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > goroutines && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.LessOrEqual(t, runtime.NumGoroutine(), goroutines)
+
+	_, err = CallGet(context.Background(), conn, "_api/echo", nil)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, errVstSocketClosed))
+}
+
+func Test_vstConnection_IdleTimeout(t *testing.T) {
+	server := newVstTestServer(t, "root", "secret")
+
+	conn := NewVstConnection(VstConfiguration{
+		Endpoint:       NewRoundRobinEndpoints([]string{server.endpoint()}),
+		Authentication: NewBasicAuth("root", "secret"),
+		IdleTimeout:    50 * time.Millisecond,
+	})
+	defer conn.(io.Closer).Close()
+
+	_, err := CallGet(context.Background(), conn, "_api/echo", nil)
+	require.NoError(t, err)
+
+	v := conn.(*vstConnection)
+	require.Eventually(t, func() bool {
+		v.lock.Lock()
+		defer v.lock.Unlock()
+
+		return v.sockets[server.endpoint()].isClosed()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The socket is opened again for the next request.
+	_, err = CallGet(context.Background(), conn, "_api/echo", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&server.connections))
+}
+
+func Test_vstConnection_Dial(t *testing.T) {
+	// The server accepts the TCP connections, but it never answers the TLS handshake.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(io.Discard, conn)
+				conn.Close()
+			}()
+		}
+	}()
+
+	conn := NewVstConnection(VstConfiguration{
+		Endpoint:    NewRoundRobinEndpoints([]string{"https://" + listener.Addr().String()}),
+		DialTimeout: 5 * time.Second,
+	})
+	defer conn.(io.Closer).Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := CallGet(ctx, conn, "_api/version", nil)
+		done <- err
+	}()
+
+	// The connection is not locked while the socket is opened.
+	time.Sleep(50 * time.Millisecond)
+	start := time.Now()
+	_, err = conn.NewRequest(http.MethodGet, "_api/version")
+	require.NoError(t, err)
+	conn.GetAuthentication()
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+
+	cancel()
+	err = <-done
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+type vstTestEcho struct {
+	Database   string            `json:"database"`
+	Type       int64             `json:"type"`
+	Path       string            `json:"path"`
+	Parameters map[string]string `json:"parameters"`
+	Body       map[string]string `json:"body"`
+}
+
+// vstTestServer is a minimal VST 1.1 server, which responds to the requests according to their paths.
+type vstTestServer struct {
+	t                  *testing.T
+	listener           net.Listener
+	username, password string
+
+	connections     int32
+	authentications int32
+	slowPending     int32
+	releaseSlow     chan struct{}
+}
+
+func newVstTestServer(t *testing.T, username, password string) *vstTestServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &vstTestServer{
+		t:           t,
+		listener:    listener,
+		username:    username,
+		password:    password,
+		releaseSlow: make(chan struct{}),
+	}
+	t.Cleanup(func() {
+		listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			atomic.AddInt32(&s.connections, 1)
+			go s.serve(conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *vstTestServer) endpoint() string {
+	return "http://" + s.listener.Addr().String()
+}
+
+func (s *vstTestServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	protocol := make([]byte, len(vstProtocolHeader))
+	if _, err := io.ReadFull(conn, protocol); err != nil || !bytes.Equal(protocol, vstProtocolHeader) {
+		return
+	}
+
+	var writeLock sync.Mutex
+	respond := func(id uint64, code int, meta map[string]string, body interface{}) {
+		header, err := velocypack.Marshal([]interface{}{1, 2, code, meta})
+		require.NoError(s.t, err)
+
+		parts := [][]byte{header}
+		if body != nil {
+			data, err := velocypack.Marshal(body)
+			require.NoError(s.t, err)
+			parts = append(parts, data)
+		}
+
+		chunks, err := buildVstChunks(id, vstChunkHeaderSize+16, parts...)
+		require.NoError(s.t, err)
+
+		writeLock.Lock()
+		defer writeLock.Unlock()
+		for _, chunk := range chunks {
+			chunk.writeTo(conn)
+		}
+	}
+
+	authenticated := false
+	messages := map[uint64]*vstMessage{}
+	for {
+		chunk, err := readVstChunk(conn)
+		if err != nil {
+			return
+		}
+
+		message, ok := messages[chunk.messageID]
+		if !ok {
+			message = &vstMessage{}
+			messages[chunk.messageID] = message
+		}
+		data, complete := message.add(chunk)
+		if !complete {
+			continue
+		}
+		delete(messages, chunk.messageID)
+		id := chunk.messageID
+
+		header := velocypack.Slice(data)
+		size, err := header.ByteSize()
+		require.NoError(s.t, err)
+
+		var fields []interface{}
+		require.NoError(s.t, velocypack.Unmarshal(header[:size], &fields))
+
+		if fields[1] == vstMessageTypeAuthentication {
+			atomic.AddInt32(&s.authentications, 1)
+			if fields[2] == "plain" && fields[3] == s.username && fields[4] == s.password {
+				authenticated = true
+				respond(id, http.StatusOK, map[string]string{}, nil)
+			} else {
+				respond(id, http.StatusUnauthorized, map[string]string{},
+					map[string]interface{}{"error": true, "code": 401, "errorNum": 11, "errorMessage": "not authorized"})
+			}
+			continue
+		}
+
+		if !authenticated {
+			respond(id, http.StatusUnauthorized, map[string]string{}, nil)
+			continue
+		}
+
+		echo := vstTestEcho{
+			Database: fields[2].(string),
+			Type:     int64(fields[3].(int)),
+			Path:     fields[4].(string),
+		}
+		if len(data) > int(size) {
+			require.NoError(s.t, velocypack.Unmarshal(data[size:], &echo.Body))
+		}
+		require.NoError(s.t, (vpackDecoder{}).Reencode(fields[5], &echo.Parameters))
+
+		switch echo.Path {
+		case "/_api/echo":
+			respond(id, http.StatusOK, map[string]string{"X-Arango-Test": "echo"}, echo)
+		case "/_api/slow":
+			atomic.AddInt32(&s.slowPending, 1)
+			go func() {
+				<-s.releaseSlow
+				respond(id, http.StatusOK, map[string]string{}, echo)
+			}()
+		case "/_api/hang":
+			// The response is never sent.
+		case "/_api/close":
+			return
+		default:
+			respond(id, http.StatusNotFound, map[string]string{},
+				map[string]interface{}{"error": true, "code": 404, "errorNum": 1203, "errorMessage": "not found"})
+		}
+	}
+}
@@ -34,6 +34,8 @@ const (
 
 	keyAsyncRequest ContextKey = "arangodb-async-request"
 	keyAsyncID      ContextKey = "arangodb-async-id"
+
+	keyIdempotent ContextKey = "arangodb-idempotent"
 )
 
 // contextOrBackground returns the given context if it is not nil.
@@ -71,6 +73,12 @@ func WithAsyncID(parent context.Context, asyncID string) context.Context {
 	return context.WithValue(contextOrBackground(parent), keyAsyncID, asyncID)
 }
 
+// WithIdempotent is used to mark the request as idempotent or not idempotent for the retry policy wrapper,
+// e.g. a POST request which can be safely repeated.
+func WithIdempotent(parent context.Context, idempotent bool) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyIdempotent, idempotent)
+}
+
 //
 // READ METHODS
 //
@@ -100,3 +108,17 @@ func HasAsyncID(ctx context.Context) (string, bool) {
 
 	return "", false
 }
+
+// IsIdempotent returns the idempotency of the request set with WithIdempotent.
+// The second value is false when it is not set.
+func IsIdempotent(ctx context.Context) (bool, bool) {
+	if ctx != nil {
+		if q := ctx.Value(keyIdempotent); q != nil {
+			if v, ok := q.(bool); ok {
+				return v, true
+			}
+		}
+	}
+
+	return false, false
+}
@@ -23,7 +23,12 @@
 package connection
 
 import (
+	"context"
 	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 func deferCloser(closer io.ReadCloser) error {
@@ -45,3 +50,43 @@ func dropBodyData(closer io.ReadCloser) error {
 		}
 	}
 }
+
+// doWithStream performs the request with the Stream function of the connection,
+// and decodes the response body into the output.
+// When allowed status codes are provided and the response code is not one of them, an ArangoError is returned.
+func doWithStream(ctx context.Context, c Connection, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	resp, body, err := c.Stream(ctx, request)
+	if err != nil {
+		return resp, err
+	}
+
+	// The body should be closed at the end of the function.
+	defer dropBodyData(body)
+
+	if len(allowedStatusCodes) > 0 {
+		found := false
+		for _, e := range allowedStatusCodes {
+			if resp.Code() == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			var respStruct shared.Response
+			// try parse as ArangoDB error response
+			_ = c.Decoder(resp.Content()).Decode(body, &respStruct)
+			return resp, respStruct.AsArangoErrorWithCode(resp.Code())
+		}
+	}
+
+	if output != nil {
+		// The output should be stored in the output variable.
+		if err = c.Decoder(resp.Content()).Decode(body, output); err != nil {
+			if err != io.EOF {
+				return resp, errors.WithStack(err)
+			}
+		}
+	}
+
+	return resp, nil
+}
@@ -97,7 +97,7 @@ func getBytesDecoder() Decoder {
 var ErrReaderOutputBytes = errors.New("use *[]byte as output argument")
 
 // ErrWriterInputBytes is the error to inform caller about invalid input argument.
-var ErrWriterInputBytes = errors.New("use []byte as input argument")
+var ErrWriterInputBytes = errors.New("use []byte or io.Reader as input argument")
 
 var bytesDecoderObj Decoder = &bytesDecoder{}
 
@@ -121,10 +121,15 @@ func (j bytesDecoder) Decode(reader io.Reader, obj interface{}) error {
 }
 
 // Encode encodes bytes to the writer.
+// The input can be a slice of bytes or an io.Reader which is copied to the writer.
 func (j bytesDecoder) Encode(writer io.Writer, obj interface{}) error {
-	if bytes, ok := obj.([]byte); ok {
-		writer.Write(bytes)
-		return nil
+	switch v := obj.(type) {
+	case []byte:
+		_, err := writer.Write(v)
+		return err
+	case io.Reader:
+		_, err := io.Copy(writer, v)
+		return err
 	}
 
 	return ErrWriterInputBytes
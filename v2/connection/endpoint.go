@@ -32,6 +32,15 @@ type Endpoint interface {
 	List() []string
 }
 
+// UpdatableEndpoint is an Endpoint which list of endpoints can be changed in place, e.g. by the EndpointSynchronizer.
+// The endpoints returned by NewRoundRobinEndpoints and NewMaglevHashEndpoints are updatable.
+type UpdatableEndpoint interface {
+	Endpoint
+	// Update replaces the list of known endpoints.
+	// The requests, for which the endpoint has already been chosen, are not affected.
+	Update(endpoints []string) error
+}
+
 var (
 	urlFixer = strings.NewReplacer(
 		"tcp://", "http://",
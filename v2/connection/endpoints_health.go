@@ -0,0 +1,361 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of the circuit breaker of an endpoint.
+type CircuitState string
+
+const (
+	// CircuitClosed means that the endpoint is healthy and it is used for requests.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen means that the endpoint is unhealthy and it is not used until the cool-down period ends.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen means that the cool-down period has ended and the endpoint is probed before it is used again.
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// HealthEndpointConfiguration is the configuration of the Endpoint returned by NewHealthEndpoints.
+type HealthEndpointConfiguration struct {
+	// WindowSize is the number of the recent requests to an endpoint for which the error rate is calculated.
+	// The default is 20.
+	WindowSize int
+
+	// MinRequests is the number of the recent requests which are needed before the circuit can be opened.
+	// The default is 5.
+	MinRequests int
+
+	// ErrorRateThreshold is the error rate of the recent requests at which the circuit is opened. The default is 0.5.
+	ErrorRateThreshold float64
+
+	// CoolDown is the time after which an open circuit is probed. The default is 30 seconds.
+	// A healthy endpoint, which has not been used for that time because it was slower than others,
+	// is used again for a single request to refresh its latency.
+	CoolDown time.Duration
+
+	// LatencyTolerance is the relative difference of the latency to the fastest endpoint,
+	// up to which the endpoints are considered as equally fast and the requests are spread between them.
+	// The default is 0.2.
+	LatencyTolerance float64
+
+	// LatencySmoothing is the weight of the latest request in the moving average of the latency. The default is 0.2.
+	LatencySmoothing float64
+}
+
+func (h HealthEndpointConfiguration) withDefaults() HealthEndpointConfiguration {
+	if h.WindowSize <= 0 {
+		h.WindowSize = 20
+	}
+	if h.MinRequests <= 0 {
+		h.MinRequests = 5
+	}
+	if h.MinRequests > h.WindowSize {
+		h.MinRequests = h.WindowSize
+	}
+	if h.ErrorRateThreshold <= 0 {
+		h.ErrorRateThreshold = 0.5
+	}
+	if h.CoolDown <= 0 {
+		h.CoolDown = 30 * time.Second
+	}
+	if h.LatencyTolerance <= 0 {
+		h.LatencyTolerance = 0.2
+	}
+	if h.LatencySmoothing <= 0 || h.LatencySmoothing > 1 {
+		h.LatencySmoothing = 0.2
+	}
+
+	return h
+}
+
+// EndpointHealth is the health of an endpoint.
+type EndpointHealth struct {
+	Endpoint string
+	State    CircuitState
+	// Latency is the moving average of the latency of the successful requests.
+	Latency time.Duration
+	// ErrorRate is the error rate of the recent requests.
+	ErrorRate float64
+	// OpenedAt is the time when the circuit has been opened. It is zero for the closed circuit.
+	OpenedAt time.Time
+}
+
+// HealthEndpoint is an Endpoint which chooses the healthy endpoint with the lowest latency.
+// The results of the requests are reported by the connection returned by NewHealthCheckWrapper.
+type HealthEndpoint interface {
+	UpdatableEndpoint
+
+	// Report records the result of a request sent to the endpoint.
+	Report(endpoint string, latency time.Duration, failed bool)
+	// Health returns the health of the known endpoints.
+	Health() []EndpointHealth
+}
+
+// healthProber is implemented by the endpoint returned by NewHealthEndpoints.
+// The connection returned by NewHealthCheckWrapper probes the endpoints which it returns.
+type healthProber interface {
+	// dueProbes returns the endpoints which cool-down period has ended, and marks them as probed.
+	dueProbes() []string
+	// probed records the result of the probe of the endpoint.
+	probed(endpoint string, latency time.Duration, failed bool)
+}
+
+// NewHealthEndpoints returns Endpoint manager which tracks the error rate and the latency of each endpoint.
+// The circuit of an endpoint is opened when the error rate of the recent requests exceeds the threshold,
+// and the endpoint is not used until it responds to `/_api/version` after the cool-down period.
+// The requests are sent to the healthy endpoint with the lowest latency.
+// When all circuits are open, the endpoint which circuit has been opened first is used.
+func NewHealthEndpoints(eps []string, config HealthEndpointConfiguration) HealthEndpoint {
+	e := &healthEndpoints{
+		config: config.withDefaults(),
+		states: map[string]*endpointHealthState{},
+	}
+	e.update(eps)
+
+	return e
+}
+
+type endpointHealthState struct {
+	state    CircuitState
+	latency  time.Duration
+	results  []bool
+	next     int
+	openedAt time.Time
+	// usedAt is the time of the last result reported for the endpoint.
+	usedAt time.Time
+}
+
+// errorRate returns the error rate of the recent requests and the number of the recent requests.
+func (s *endpointHealthState) errorRate() (float64, int) {
+	if len(s.results) == 0 {
+		return 0, 0
+	}
+
+	failures := 0
+	for _, failed := range s.results {
+		if failed {
+			failures++
+		}
+	}
+
+	return float64(failures) / float64(len(s.results)), len(s.results)
+}
+
+func (s *endpointHealthState) close(latency time.Duration) {
+	s.state = CircuitClosed
+	s.latency = latency
+	s.results = s.results[:0]
+	s.next = 0
+	s.openedAt = time.Time{}
+	s.usedAt = time.Now()
+}
+
+var _ healthProber = &healthEndpoints{}
+
+type healthEndpoints struct {
+	config HealthEndpointConfiguration
+
+	lock      sync.Mutex
+	endpoints []string
+	states    map[string]*endpointHealthState
+	index     int
+}
+
+func (e *healthEndpoints) List() []string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	return e.endpoints
+}
+
+// Update replaces the endpoints. The health of the endpoints which are still known is kept.
+func (e *healthEndpoints) Update(endpoints []string) error {
+	if len(endpoints) == 0 {
+		return errors.New("no endpoints provided")
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.update(endpoints)
+
+	return nil
+}
+
+func (e *healthEndpoints) update(endpoints []string) {
+	states := make(map[string]*endpointHealthState, len(endpoints))
+	for _, ep := range endpoints {
+		if s, ok := e.states[ep]; ok {
+			states[ep] = s
+		} else {
+			states[ep] = &endpointHealthState{state: CircuitClosed, usedAt: time.Now()}
+		}
+	}
+
+	e.endpoints = append([]string(nil), endpoints...)
+	e.states = states
+}
+
+func (e *healthEndpoints) Get(providedEp, _, _ string) (string, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if len(e.endpoints) == 0 {
+		return "", errors.New("no endpoints known")
+	}
+
+	if _, ok := e.states[providedEp]; ok {
+		return providedEp, nil
+	}
+
+	var best time.Duration = -1
+	for _, ep := range e.endpoints {
+		if s := e.states[ep]; s.state == CircuitClosed && (best < 0 || s.latency < best) {
+			best = s.latency
+		}
+	}
+
+	if best < 0 {
+		// All circuits are open, so the endpoint which is expected to recover first is used.
+		r := e.endpoints[0]
+		for _, ep := range e.endpoints[1:] {
+			if e.states[ep].openedAt.Before(e.states[r].openedAt) {
+				r = ep
+			}
+		}
+
+		return r, nil
+	}
+
+	limit := best + time.Duration(float64(best)*e.config.LatencyTolerance)
+	candidates := make([]string, 0, len(e.endpoints))
+	for _, ep := range e.endpoints {
+		s := e.states[ep]
+		if s.state != CircuitClosed {
+			continue
+		}
+
+		if s.latency > limit && time.Since(s.usedAt) >= e.config.CoolDown {
+			// The latency of the endpoint is outdated, so it is refreshed by this request.
+			s.usedAt = time.Now()
+			return ep, nil
+		}
+
+		if s.latency <= limit {
+			candidates = append(candidates, ep)
+		}
+	}
+
+	e.index++
+	return candidates[e.index%len(candidates)], nil
+}
+
+func (e *healthEndpoints) Report(endpoint string, latency time.Duration, failed bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	s, ok := e.states[endpoint]
+	if !ok || s.state != CircuitClosed {
+		// The results of the requests, which have been sent before the circuit has been opened, are ignored.
+		return
+	}
+
+	s.usedAt = time.Now()
+	if len(s.results) < e.config.WindowSize {
+		s.results = append(s.results, failed)
+	} else {
+		s.results[s.next] = failed
+		s.next = (s.next + 1) % e.config.WindowSize
+	}
+
+	if !failed {
+		if s.latency == 0 {
+			s.latency = latency
+		} else {
+			smoothing := e.config.LatencySmoothing
+			s.latency = time.Duration(smoothing*float64(latency) + (1-smoothing)*float64(s.latency))
+		}
+	}
+
+	if rate, count := s.errorRate(); count >= e.config.MinRequests && rate >= e.config.ErrorRateThreshold {
+		s.state = CircuitOpen
+		s.openedAt = time.Now()
+	}
+}
+
+func (e *healthEndpoints) Health() []EndpointHealth {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	health := make([]EndpointHealth, 0, len(e.endpoints))
+	for _, ep := range e.endpoints {
+		s := e.states[ep]
+		rate, _ := s.errorRate()
+
+		health = append(health, EndpointHealth{
+			Endpoint:  ep,
+			State:     s.state,
+			Latency:   s.latency,
+			ErrorRate: rate,
+			OpenedAt:  s.openedAt,
+		})
+	}
+
+	return health
+}
+
+func (e *healthEndpoints) dueProbes() []string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	var due []string
+	for _, ep := range e.endpoints {
+		if s := e.states[ep]; s.state == CircuitOpen && time.Since(s.openedAt) >= e.config.CoolDown {
+			s.state = CircuitHalfOpen
+			due = append(due, ep)
+		}
+	}
+
+	return due
+}
+
+func (e *healthEndpoints) probed(endpoint string, latency time.Duration, failed bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	s, ok := e.states[endpoint]
+	if !ok || s.state != CircuitHalfOpen {
+		return
+	}
+
+	if failed {
+		s.state = CircuitOpen
+		s.openedAt = time.Now()
+		return
+	}
+
+	s.close(latency)
+}
@@ -0,0 +1,189 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_healthEndpoints_Get(t *testing.T) {
+	e := NewHealthEndpoints([]string{"a", "b", "c"}, HealthEndpointConfiguration{CoolDown: time.Hour})
+
+	// The endpoints without the latency are used first.
+	used := map[string]bool{}
+	for i := 0; i < 3; i++ {
+		ep, err := e.Get("", http.MethodGet, "/_api/version")
+		require.NoError(t, err)
+		used[ep] = true
+	}
+	assert.Len(t, used, 3)
+
+	e.Report("a", 100*time.Millisecond, false)
+	e.Report("b", 10*time.Millisecond, false)
+	e.Report("c", 11*time.Millisecond, false)
+
+	// The requests are spread between the endpoints which are almost as fast as the fastest one.
+	used = map[string]bool{}
+	for i := 0; i < 10; i++ {
+		ep, err := e.Get("", http.MethodGet, "/_api/version")
+		require.NoError(t, err)
+		used[ep] = true
+	}
+	assert.Equal(t, map[string]bool{"b": true, "c": true}, used)
+
+	// The provided endpoint is always used.
+	ep, err := e.Get("a", http.MethodGet, "/_api/cursor/1")
+	require.NoError(t, err)
+	assert.Equal(t, "a", ep)
+}
+
+func Test_healthEndpoints_Circuit(t *testing.T) {
+	e := NewHealthEndpoints([]string{"a", "b"}, HealthEndpointConfiguration{
+		WindowSize:  4,
+		MinRequests: 4,
+		CoolDown:    time.Hour,
+	})
+
+	e.Report("a", time.Millisecond, false)
+	e.Report("b", 2*time.Millisecond, false)
+
+	e.Report("a", time.Millisecond, true)
+	e.Report("a", time.Millisecond, false)
+	assert.Equal(t, CircuitClosed, e.Health()[0].State)
+
+	e.Report("a", time.Millisecond, true)
+	health := e.Health()
+	assert.Equal(t, CircuitOpen, health[0].State)
+	assert.Equal(t, 0.5, health[0].ErrorRate)
+	assert.False(t, health[0].OpenedAt.IsZero())
+	assert.Equal(t, CircuitClosed, health[1].State)
+
+	// The faster endpoint is not used while its circuit is open.
+	for i := 0; i < 3; i++ {
+		ep, err := e.Get("", http.MethodGet, "/_api/version")
+		require.NoError(t, err)
+		assert.Equal(t, "b", ep)
+	}
+
+	// When all circuits are open, the endpoint which circuit has been opened first is used.
+	for i := 0; i < 4; i++ {
+		e.Report("b", time.Millisecond, true)
+	}
+	ep, err := e.Get("", http.MethodGet, "/_api/version")
+	require.NoError(t, err)
+	assert.Equal(t, "a", ep)
+	assert.Empty(t, e.(healthProber).dueProbes())
+
+	// The health of the known endpoints is kept.
+	require.NoError(t, e.Update([]string{"b", "c"}))
+	ep, err = e.Get("", http.MethodGet, "/_api/version")
+	require.NoError(t, err)
+	assert.Equal(t, "c", ep)
+	assert.Equal(t, CircuitOpen, e.Health()[0].State)
+}
+
+func TestHealthCheckWrapper(t *testing.T) {
+	var unhealthy int32 = 1
+	var probes int32
+	slow := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_api/version" {
+			atomic.AddInt32(&probes, 1)
+		}
+
+		if atomic.LoadInt32(&unhealthy) == 1 {
+			writeTestError(w, http.StatusServiceUnavailable, 503)
+			return
+		}
+
+		writeTestResponse(w, http.StatusOK, struct{}{})
+	}))
+
+	fast := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, http.StatusOK, struct{}{})
+	}))
+
+	endpoint := NewHealthEndpoints([]string{slow.URL, fast.URL}, HealthEndpointConfiguration{
+		WindowSize:  2,
+		MinRequests: 2,
+		CoolDown:    50 * time.Millisecond,
+	})
+	conn := NewHealthCheckWrapper(newTestConnection(endpoint))
+
+	for i := 0; i < 2; i++ {
+		_, err := CallWithEndpoint(context.Background(), conn, slow.URL, http.MethodGet, "_api/collection", nil)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, CircuitOpen, endpoint.Health()[0].State)
+
+	// The failed probe opens the circuit again.
+	time.Sleep(60 * time.Millisecond)
+	_, err := CallGet(context.Background(), conn, "_api/collection", nil)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&probes) == 1 && endpoint.Health()[0].State == CircuitOpen
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The successful probe closes the circuit.
+	atomic.StoreInt32(&unhealthy, 0)
+	time.Sleep(60 * time.Millisecond)
+	resp, err := CallGet(context.Background(), conn, "_api/collection", nil)
+	require.NoError(t, err)
+	assert.Equal(t, fast.URL, resp.Endpoint())
+	require.Eventually(t, func() bool {
+		return endpoint.Health()[0].State == CircuitClosed
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&probes))
+}
+
+func Test_isHealthFailure(t *testing.T) {
+	testCases := map[string]struct {
+		code     int
+		err      error
+		failed   bool
+		reported bool
+	}{
+		"success":      {code: http.StatusOK, reported: true},
+		"client error": {code: http.StatusNotFound, reported: true},
+		"server error": {code: http.StatusServiceUnavailable, failed: true, reported: true},
+		"network":      {err: context.DeadlineExceeded, failed: true, reported: true},
+		"canceled":     {err: context.Canceled},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var resp Response
+			if tc.err == nil {
+				resp = &httpResponse{response: &http.Response{StatusCode: tc.code}}
+			}
+
+			failed, reported := isHealthFailure(resp, tc.err)
+			assert.Equal(t, tc.failed, failed)
+			assert.Equal(t, tc.reported, reported)
+		})
+	}
+}
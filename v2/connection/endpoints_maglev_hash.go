@@ -25,6 +25,7 @@ import (
 	"math/big"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/kkdai/maglev"
 	"github.com/pkg/errors"
@@ -40,10 +41,7 @@ func NewMaglevHashEndpoints(eps []string, extractor RequestHashValueExtractor) (
 	// order of endpoints affects hashing result
 	sort.Strings(eps)
 
-	// lookupSize must be equal or greater than len(eps) and it must be a prime number
-	lookupSize := findNextPrime(uint64(len(eps)))
-
-	table, err := maglev.NewMaglev(eps, lookupSize)
+	table, err := newMaglevTable(eps)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +53,14 @@ func NewMaglevHashEndpoints(eps []string, extractor RequestHashValueExtractor) (
 	}, nil
 }
 
+// newMaglevTable returns the lookup table for the sorted endpoints.
+func newMaglevTable(eps []string) (*maglev.Maglev, error) {
+	// lookupSize must be equal or greater than len(eps) and it must be a prime number
+	lookupSize := findNextPrime(uint64(len(eps)))
+
+	return maglev.NewMaglev(eps, lookupSize)
+}
+
 func findNextPrime(i uint64) uint64 {
 	bigInt := big.NewInt(0).SetUint64(i)
 
@@ -69,15 +75,46 @@ func findNextPrime(i uint64) uint64 {
 
 type maglevHashEndpoints struct {
 	extractor   RequestHashValueExtractor
+	lock        sync.RWMutex
 	endpoints   []string
 	maglevTable *maglev.Maglev
 }
 
 func (e *maglevHashEndpoints) List() []string {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
 	return e.endpoints
 }
 
+// Update replaces the endpoints and rebuilds the lookup table.
+// Maglev hashing keeps most of the values assigned to the same endpoints as before.
+func (e *maglevHashEndpoints) Update(endpoints []string) error {
+	if len(endpoints) == 0 {
+		return errors.New("no endpoints provided")
+	}
+
+	eps := append([]string(nil), endpoints...)
+	sort.Strings(eps)
+
+	table, err := newMaglevTable(eps)
+	if err != nil {
+		return err
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.endpoints = eps
+	e.maglevTable = table
+
+	return nil
+}
+
 func (e *maglevHashEndpoints) Get(providedEp, requestMethod, requestPath string) (string, error) {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
 	if len(e.endpoints) == 0 {
 		return "", errors.New("no endpoints known")
 	}
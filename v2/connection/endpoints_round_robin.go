@@ -45,9 +45,25 @@ type roundRobinEndpoints struct {
 }
 
 func (e *roundRobinEndpoints) List() []string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
 	return e.endpoints
 }
 
+func (e *roundRobinEndpoints) Update(endpoints []string) error {
+	if len(endpoints) == 0 {
+		return errors.New("no endpoints provided")
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.endpoints = append([]string(nil), endpoints...)
+
+	return nil
+}
+
 func (e *roundRobinEndpoints) Get(providedEp, _, _ string) (string, error) {
 	e.lock.Lock()
 	defer e.lock.Unlock()
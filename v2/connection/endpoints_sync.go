@@ -0,0 +1,208 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// EndpointsChange describes the change of the endpoints found by the EndpointSynchronizer.
+type EndpointsChange struct {
+	// Previous holds the endpoints before the change.
+	Previous []string
+	// Current holds the endpoints after the change.
+	Current []string
+	// Added holds the endpoints which are in Current, but not in Previous.
+	Added []string
+	// Removed holds the endpoints which are in Previous, but not in Current.
+	Removed []string
+}
+
+// EndpointSyncConfiguration is the configuration of the EndpointSynchronizer.
+type EndpointSyncConfiguration struct {
+	// Interval is the time between the synchronizations started by Run. The default is 1 minute.
+	Interval time.Duration
+
+	// Database is used to call `_db/<database>/_api/cluster/endpoints`.
+	// It should be set when the user does not have access to the `_system` database.
+	Database string
+
+	// OnChange is called after the endpoints have been changed, e.g. to log the change of the cluster topology.
+	OnChange func(change EndpointsChange)
+
+	// OnError is called when a synchronization started by Run fails. The previous endpoints are still used.
+	OnError func(err error)
+}
+
+func (e EndpointSyncConfiguration) getInterval() time.Duration {
+	if e.Interval <= 0 {
+		return time.Minute
+	}
+
+	return e.Interval
+}
+
+// NewEndpointSynchronizer returns the synchronizer which updates the endpoint of the connection
+// with the coordinators of the cluster.
+// The endpoint of the connection must implement UpdatableEndpoint, because it is updated in place,
+// so the requests which are already sent are not dropped.
+func NewEndpointSynchronizer(conn Connection, config EndpointSyncConfiguration) *EndpointSynchronizer {
+	return &EndpointSynchronizer{
+		conn:   conn,
+		config: config,
+	}
+}
+
+// EndpointSynchronizer fetches the endpoints of the coordinators from the cluster
+// and updates the endpoint of the connection.
+type EndpointSynchronizer struct {
+	conn   Connection
+	config EndpointSyncConfiguration
+
+	lock sync.Mutex
+}
+
+type clusterEndpointsResponse struct {
+	shared.ResponseStruct `json:",inline"`
+
+	Endpoints []clusterEndpoint `json:"endpoints,omitempty"`
+}
+
+type clusterEndpoint struct {
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// Synchronize fetches the endpoints from the cluster and updates the endpoint of the connection.
+// When the connection is connected to a single server, nothing happens.
+func (s *EndpointSynchronizer) Synchronize(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	endpoint, ok := s.conn.GetEndpoint().(UpdatableEndpoint)
+	if !ok {
+		return errors.Errorf("endpoint of the connection can not be updated")
+	}
+
+	url := NewUrl("_api", "cluster", "endpoints")
+	if s.config.Database != "" {
+		url = NewUrl("_db", s.config.Database, "_api", "cluster", "endpoints")
+	}
+
+	var response clusterEndpointsResponse
+	resp, err := CallGet(ctx, s.conn, url, &response)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch code := resp.Code(); code {
+	case http.StatusOK:
+	default:
+		err := response.AsArangoErrorWithCode(code)
+		// 501 with ErrorNum 9 is returned by a single server since 3.7, earlier versions returned 403 and ErrorNum 11.
+		if shared.IsArangoErrorWithErrorNum(err, shared.ErrHttpForbidden, shared.ErrHttpInternal, 0,
+			shared.ErrNotImplemented, shared.ErrForbidden) {
+			return nil
+		}
+
+		return err
+	}
+
+	current := make([]string, 0, len(response.Endpoints))
+	for _, e := range response.Endpoints {
+		current = append(current, FixupEndpointURLScheme(e.Endpoint))
+	}
+	if len(current) == 0 {
+		// The endpoints which are known are still better than nothing.
+		return nil
+	}
+
+	change := newEndpointsChange(endpoint.List(), current)
+	if len(change.Added) == 0 && len(change.Removed) == 0 {
+		return nil
+	}
+
+	if err := endpoint.Update(current); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if s.config.OnChange != nil {
+		s.config.OnChange(change)
+	}
+
+	return nil
+}
+
+// Run synchronizes the endpoints periodically, until the context is done.
+func (s *EndpointSynchronizer) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.getInterval())
+	defer ticker.Stop()
+
+	for {
+		if err := s.Synchronize(ctx); err != nil && ctx.Err() == nil && s.config.OnError != nil {
+			s.config.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func newEndpointsChange(previous, current []string) EndpointsChange {
+	change := EndpointsChange{
+		Previous: append([]string(nil), previous...),
+		Current:  append([]string(nil), current...),
+	}
+
+	known := map[string]bool{}
+	for _, e := range previous {
+		known[e] = true
+	}
+
+	found := map[string]bool{}
+	for _, e := range current {
+		found[e] = true
+		if !known[e] {
+			change.Added = append(change.Added, e)
+		}
+	}
+
+	for _, e := range previous {
+		if !found[e] {
+			change.Removed = append(change.Removed, e)
+		}
+	}
+
+	sort.Strings(change.Added)
+	sort.Strings(change.Removed)
+
+	return change
+}
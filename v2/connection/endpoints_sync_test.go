@@ -0,0 +1,226 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// endpointsTestServer returns the endpoints which are set in the test from `_api/cluster/endpoints`.
+type endpointsTestServer struct {
+	*httptest.Server
+
+	lock      sync.Mutex
+	endpoints []string
+	code      int
+	calls     int32
+}
+
+func newEndpointsTestServer(t *testing.T) *endpointsTestServer {
+	s := &endpointsTestServer{code: http.StatusOK}
+	s.Server = newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/_api/cluster/endpoints") {
+			writeTestResponse(w, http.StatusOK, struct{}{})
+			return
+		}
+		atomic.AddInt32(&s.calls, 1)
+
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		if s.code != http.StatusOK {
+			errorNum := s.code
+			if s.code == http.StatusNotImplemented {
+				errorNum = 9
+			}
+
+			writeTestError(w, s.code, errorNum)
+			return
+		}
+
+		response := clusterEndpointsResponse{}
+		for _, e := range s.endpoints {
+			response.Endpoints = append(response.Endpoints, clusterEndpoint{Endpoint: e})
+		}
+		writeTestResponse(w, http.StatusOK, response)
+	}))
+
+	return s
+}
+
+func (s *endpointsTestServer) set(code int, endpoints ...string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.code = code
+	s.endpoints = endpoints
+}
+
+func TestEndpointSynchronizer_Synchronize(t *testing.T) {
+	server := newEndpointsTestServer(t)
+	other := newEndpointsTestServer(t)
+	endpoint := NewRoundRobinEndpoints([]string{server.URL})
+	conn := newTestConnection(endpoint)
+
+	var changes []EndpointsChange
+	s := NewEndpointSynchronizer(conn, EndpointSyncConfiguration{
+		OnChange: func(change EndpointsChange) {
+			changes = append(changes, change)
+		},
+	})
+
+	t.Run("endpoints are added", func(t *testing.T) {
+		server.set(http.StatusOK, "tcp://"+strings.TrimPrefix(server.URL, "http://"), other.URL)
+
+		require.NoError(t, s.Synchronize(context.Background()))
+		assert.ElementsMatch(t, []string{server.URL, other.URL}, endpoint.List())
+
+		require.Len(t, changes, 1)
+		assert.Equal(t, []string{server.URL}, changes[0].Previous)
+		assert.Equal(t, []string{other.URL}, changes[0].Added)
+		assert.Empty(t, changes[0].Removed)
+	})
+
+	t.Run("nothing is changed", func(t *testing.T) {
+		server.set(http.StatusOK, other.URL, server.URL)
+		other.set(http.StatusOK, other.URL, server.URL)
+
+		require.NoError(t, s.Synchronize(context.Background()))
+		require.NoError(t, s.Synchronize(context.Background()))
+		assert.Len(t, changes, 1)
+	})
+
+	t.Run("in-flight requests are not dropped", func(t *testing.T) {
+		req, err := conn.NewRequestWithEndpoint(other.URL, http.MethodGet, "_api", "version")
+		require.NoError(t, err)
+
+		server.set(http.StatusOK, server.URL)
+		other.set(http.StatusOK, server.URL)
+		require.NoError(t, s.Synchronize(context.Background()))
+		assert.Equal(t, []string{server.URL}, endpoint.List())
+
+		require.Len(t, changes, 2)
+		assert.Equal(t, []string{other.URL}, changes[1].Removed)
+
+		resp, err := conn.Do(context.Background(), req, nil, http.StatusOK)
+		require.NoError(t, err)
+		assert.Equal(t, other.URL, resp.Endpoint())
+	})
+
+	t.Run("single server", func(t *testing.T) {
+		server.set(http.StatusNotImplemented)
+
+		require.NoError(t, s.Synchronize(context.Background()))
+		assert.Equal(t, []string{server.URL}, endpoint.List())
+		assert.Len(t, changes, 2)
+	})
+
+	t.Run("no endpoints", func(t *testing.T) {
+		server.set(http.StatusOK)
+
+		require.NoError(t, s.Synchronize(context.Background()))
+		assert.Equal(t, []string{server.URL}, endpoint.List())
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		server.set(http.StatusServiceUnavailable)
+
+		require.Error(t, s.Synchronize(context.Background()))
+		assert.Equal(t, []string{server.URL}, endpoint.List())
+	})
+}
+
+func TestEndpointSynchronizer_Maglev(t *testing.T) {
+	server := newEndpointsTestServer(t)
+	endpoint, err := NewMaglevHashEndpoints([]string{server.URL}, RequestDBNameValueExtractor)
+	require.NoError(t, err)
+
+	server.set(http.StatusOK, server.URL, "http://b:8529", "http://a:8529")
+
+	s := NewEndpointSynchronizer(newTestConnection(endpoint), EndpointSyncConfiguration{Database: "mydb"})
+	require.NoError(t, s.Synchronize(context.Background()))
+
+	expected := []string{server.URL, "http://a:8529", "http://b:8529"}
+	assert.Equal(t, expected, endpoint.List())
+
+	// Each database is still assigned to the same endpoint.
+	first, err := endpoint.Get("", http.MethodGet, "/_db/mydb_a/_api/version")
+	require.NoError(t, err)
+	assert.Contains(t, expected, first)
+
+	again, err := endpoint.Get("", http.MethodGet, "/_db/mydb_a/_api/document")
+	require.NoError(t, err)
+	assert.Equal(t, first, again)
+}
+
+func TestEndpointSynchronizer_NotUpdatable(t *testing.T) {
+	s := NewEndpointSynchronizer(newTestConnection(staticEndpoint{}), EndpointSyncConfiguration{})
+	require.Error(t, s.Synchronize(context.Background()))
+}
+
+type staticEndpoint struct{}
+
+func (staticEndpoint) Get(string, string, string) (string, error) {
+	return "http://localhost:8529", nil
+}
+
+func (staticEndpoint) List() []string {
+	return []string{"http://localhost:8529"}
+}
+
+func TestEndpointSynchronizer_Run(t *testing.T) {
+	server := newEndpointsTestServer(t)
+	server.set(http.StatusServiceUnavailable)
+
+	var errorsCount int32
+	s := NewEndpointSynchronizer(newTestConnection(NewRoundRobinEndpoints([]string{server.URL})),
+		EndpointSyncConfiguration{
+			Interval: 10 * time.Millisecond,
+			OnError: func(err error) {
+				atomic.AddInt32(&errorsCount, 1)
+			},
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&errorsCount) >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&server.calls), int32(3))
+}
@@ -23,8 +23,15 @@
 package connection
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"syscall"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 func NewErrorf(code int, message string, args ...interface{}) error {
@@ -70,3 +77,29 @@ func NewNotFoundError(msg string) error {
 func IsNotFoundError(err error) bool {
 	return IsCodeError(err, http.StatusNotFound)
 }
+
+// IsNetworkError returns true if the error is caused by a failed network connection,
+// e.g. a connection reset by the peer, a refused connection or a response which is cut off.
+// Errors caused by a canceled context or an exceeded context deadline are not network errors.
+func IsNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	// The errors returned by the server implement net.Error too.
+	if shared.IsArangoError(err) {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,61 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/url"
+	"syscall"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func TestIsNetworkError(t *testing.T) {
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil":              {},
+		"other":            {err: errors.New("other")},
+		"unexpected EOF":   {err: errors.WithStack(io.ErrUnexpectedEOF), expected: true},
+		"connection reset": {err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}, expected: true},
+		"connection refused": {
+			err:      errors.WithStack(&url.Error{Op: "Post", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}),
+			expected: true,
+		},
+		"EOF in url error": {err: &url.Error{Op: "Post", Err: io.EOF}, expected: true},
+		"canceled":         {err: &url.Error{Op: "Post", Err: context.Canceled}},
+		"deadline":         {err: errors.WithStack(context.DeadlineExceeded)},
+		"server error":     {err: shared.ArangoError{HasError: true, Code: 503}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsNetworkError(tc.err))
+		})
+	}
+}
@@ -0,0 +1,520 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The names of the attributes of the spans and the metrics, according to the OpenTelemetry semantic conventions.
+const (
+	AttributeDBSystem       = "db.system"
+	AttributeDBName         = "db.name"
+	AttributeDBCollection   = "db.collection.name"
+	AttributeDBOperation    = "db.operation.name"
+	AttributeDBQueryText    = "db.query.text"
+	AttributeHTTPMethod     = "http.request.method"
+	AttributeHTTPStatusCode = "http.response.status_code"
+	AttributeErrorNum       = "db.arangodb.error_num"
+	AttributeServerAddress  = "server.address"
+	AttributeServerPort     = "server.port"
+)
+
+// The names of the histograms recorded by the telemetry.
+const (
+	MetricRequestDuration = "db.client.operation.duration"
+	MetricRequestSize     = "db.client.request.size"
+	MetricResponseSize    = "db.client.response.size"
+)
+
+// Attribute is a key-value pair which describes a span or a measurement.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// SpanContext identifies a span in the W3C trace context.
+type SpanContext struct {
+	TraceID    [16]byte
+	SpanID     [8]byte
+	Sampled    bool
+	TraceState string
+}
+
+// IsValid returns true when the trace ID and the span ID are set.
+func (s SpanContext) IsValid() bool {
+	return s.TraceID != [16]byte{} && s.SpanID != [8]byte{}
+}
+
+// Span is a single traced request. The OpenTelemetry adapter is provided by the module
+// `github.com/arangodb/go-driver/v2/otel`.
+type Span interface {
+	// SpanContext returns the identifiers of the span which are propagated to the server.
+	SpanContext() SpanContext
+	SetAttributes(attributes ...Attribute)
+	// SetError records the error and marks the span as failed.
+	SetError(err error)
+	End()
+}
+
+// Tracer starts the client spans. The returned context must contain the span, so it can be propagated.
+type Tracer interface {
+	Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+}
+
+// Histogram records the distribution of the values.
+type Histogram interface {
+	Record(ctx context.Context, value float64, attributes ...Attribute)
+}
+
+// Meter creates the histograms.
+type Meter interface {
+	Histogram(name, unit, description string) Histogram
+}
+
+// TextMapCarrier holds the propagated fields. Its methods match the OpenTelemetry propagation.TextMapCarrier,
+// so it can be passed to the OpenTelemetry propagators.
+type TextMapCarrier interface {
+	Get(key string) string
+	Set(key, value string)
+	Keys() []string
+}
+
+// Propagator injects the trace context and the baggage of the context into the carrier.
+type Propagator interface {
+	Inject(ctx context.Context, carrier TextMapCarrier)
+}
+
+// QueryTextMode defines how the AQL query text is recorded.
+type QueryTextMode int
+
+const (
+	// QueryTextOmitted does not record the query text.
+	QueryTextOmitted QueryTextMode = iota
+	// QueryTextRedacted records the query text with the string and the number literals replaced with `?`.
+	// Comments are removed. The bind parameters are never recorded.
+	QueryTextRedacted
+	// QueryTextFull records the query text as it is.
+	QueryTextFull
+)
+
+// TelemetryConfiguration is the configuration of the telemetry.
+type TelemetryConfiguration struct {
+	// Tracer starts a span for each request. No spans are created when it is nil.
+	Tracer Tracer
+	// Meter creates the histograms of the request duration (in seconds) and of the request and response size
+	// (in bytes). No metrics are recorded when it is nil.
+	Meter Meter
+	// Propagator injects the trace context into the request headers.
+	// When it is nil, the context of the span is propagated with the W3C `traceparent` and `tracestate` headers.
+	Propagator Propagator
+	// QueryText defines how the text of the AQL queries is recorded in the spans. It is omitted by default.
+	QueryText QueryTextMode
+}
+
+// Telemetry records the spans and the metrics of the requests.
+// It is used by the telemetry wrappers of the connections.
+type Telemetry struct {
+	config TelemetryConfiguration
+
+	duration, requestSize, responseSize Histogram
+}
+
+// NewTelemetry returns the telemetry with the histograms created by the meter of the configuration.
+func NewTelemetry(config TelemetryConfiguration) *Telemetry {
+	t := &Telemetry{
+		config: config,
+	}
+
+	if m := config.Meter; m != nil {
+		t.duration = m.Histogram(MetricRequestDuration, "s", "Duration of the requests to ArangoDB")
+		t.requestSize = m.Histogram(MetricRequestSize, "By", "Size of the request bodies sent to ArangoDB")
+		t.responseSize = m.Histogram(MetricResponseSize, "By", "Size of the response bodies received from ArangoDB")
+	}
+
+	return t
+}
+
+// TelemetryRequest describes the request which is instrumented.
+type TelemetryRequest struct {
+	Method string
+	// URL is the path of the request, optionally with the query.
+	URL string
+	// Collection is the name of the collection, when it is not a part of the URL.
+	Collection string
+	// Body is the body of the request, from which the AQL query text is read.
+	Body interface{}
+	// Size returns the size of the encoded body. It is called only when the request size is recorded.
+	Size func() (int64, bool)
+	// Header receives the headers which propagate the trace context.
+	Header TextMapCarrier
+}
+
+// Start starts the span of the request, propagates the trace context and records the request size.
+// The returned context contains the span, and it should be used to send the request.
+func (t *Telemetry) Start(ctx context.Context, request TelemetryRequest) (context.Context, *TelemetryOperation) {
+	target := newTelemetryTarget(request)
+	o := &TelemetryOperation{
+		telemetry:  t,
+		ctx:        contextOrBackground(ctx),
+		start:      time.Now(),
+		attributes: target.attributes(),
+	}
+
+	if t.config.Tracer != nil {
+		spanAttributes := o.attributes
+		if query, ok := t.queryText(request, target); ok {
+			spanAttributes = append(spanAttributes[:len(spanAttributes):len(spanAttributes)],
+				Attribute{Key: AttributeDBQueryText, Value: query})
+		}
+
+		o.ctx, o.span = t.config.Tracer.Start(o.ctx, target.operation, spanAttributes...)
+	}
+
+	if request.Header != nil {
+		if t.config.Propagator != nil {
+			t.config.Propagator.Inject(o.ctx, request.Header)
+		} else if o.span != nil {
+			injectTraceContext(request.Header, o.span.SpanContext())
+		}
+	}
+
+	if t.requestSize != nil && request.Size != nil {
+		if size, ok := request.Size(); ok {
+			t.requestSize.Record(o.ctx, float64(size), o.attributes...)
+		}
+	}
+
+	return o.ctx, o
+}
+
+// queryText returns the AQL query text of the request, according to the configuration.
+func (t *Telemetry) queryText(request TelemetryRequest, target telemetryTarget) (string, bool) {
+	if t.config.QueryText == QueryTextOmitted || target.method != http.MethodPost {
+		return "", false
+	}
+
+	if target.route != "/_api/cursor" && target.route != "/_api/explain" {
+		return "", false
+	}
+
+	query, ok := bodyQuery(request.Body)
+	if !ok {
+		return "", false
+	}
+
+	if t.config.QueryText == QueryTextRedacted {
+		return RedactQuery(query), true
+	}
+
+	return query, true
+}
+
+// TelemetryOperation is the request instrumented by Telemetry.Start. It is finished with End.
+type TelemetryOperation struct {
+	telemetry *Telemetry
+	ctx       context.Context
+	span      Span
+	start     time.Time
+
+	attributes []Attribute
+	// serverErr is the ArangoDB error of the response, which fails the span.
+	serverErr error
+
+	once sync.Once
+}
+
+// SetResponse records the status code and the endpoint of the response.
+func (o *TelemetryOperation) SetResponse(code int, endpoint string) {
+	o.attributes = append(o.attributes, Attribute{Key: AttributeHTTPStatusCode, Value: code})
+	if host, port, ok := endpointAddress(endpoint); ok {
+		o.attributes = append(o.attributes,
+			Attribute{Key: AttributeServerAddress, Value: host}, Attribute{Key: AttributeServerPort, Value: port})
+	}
+}
+
+// SetResponseError records the error number of the ArangoDB error response.
+// Client errors, e.g. 404, are expected results of the operations, so only server errors fail the span.
+func (o *TelemetryOperation) SetResponseError(code, errorNum int, err error) {
+	o.attributes = append(o.attributes, Attribute{Key: AttributeErrorNum, Value: errorNum})
+	if code >= http.StatusInternalServerError {
+		o.serverErr = err
+	}
+}
+
+// End records the duration and the response size, and ends the span.
+// The err is the error of the request. The response size is not recorded when it is negative.
+// Only the first call has an effect.
+func (o *TelemetryOperation) End(responseSize int64, err error) {
+	o.once.Do(func() {
+		t := o.telemetry
+
+		if t.duration != nil {
+			t.duration.Record(o.ctx, time.Since(o.start).Seconds(), o.attributes...)
+		}
+		if t.responseSize != nil && err == nil && responseSize >= 0 {
+			t.responseSize.Record(o.ctx, float64(responseSize), o.attributes...)
+		}
+
+		if o.span == nil {
+			return
+		}
+
+		o.span.SetAttributes(o.attributes...)
+		if err != nil {
+			o.span.SetError(err)
+		} else if o.serverErr != nil {
+			o.span.SetError(o.serverErr)
+		}
+		o.span.End()
+	})
+}
+
+// telemetryTarget describes the target of the request.
+type telemetryTarget struct {
+	method     string
+	database   string
+	collection string
+	// route is the path of the API, e.g. `/_api/document`, which does not contain the identifiers.
+	route     string
+	operation string
+}
+
+func newTelemetryTarget(request TelemetryRequest) telemetryTarget {
+	t := telemetryTarget{
+		method:     request.Method,
+		database:   "_system",
+		collection: request.Collection,
+	}
+
+	u, err := url.Parse(request.URL)
+	if err != nil {
+		t.operation = t.method
+		return t
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) >= 2 && parts[0] == "_db" {
+		t.database = pathUnescape(parts[1])
+		parts = parts[2:]
+	}
+
+	if len(parts) >= 3 && parts[0] == "_api" {
+		switch parts[1] {
+		case "collection", "document", "edge", "index", "import":
+			t.collection = pathUnescape(parts[2])
+		}
+	}
+	if t.collection == "" {
+		t.collection = u.Query().Get("collection")
+	}
+
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	t.route = "/" + strings.Join(parts, "/")
+	t.operation = t.method + " " + t.route
+
+	return t
+}
+
+func (t telemetryTarget) attributes() []Attribute {
+	attributes := []Attribute{
+		{Key: AttributeDBSystem, Value: "arangodb"},
+		{Key: AttributeDBName, Value: t.database},
+		{Key: AttributeDBOperation, Value: t.operation},
+		{Key: AttributeHTTPMethod, Value: t.method},
+	}
+
+	if t.collection != "" {
+		attributes = append(attributes, Attribute{Key: AttributeDBCollection, Value: t.collection})
+	}
+
+	return attributes
+}
+
+func pathUnescape(s string) string {
+	if u, err := url.PathUnescape(s); err == nil {
+		return u
+	}
+
+	return s
+}
+
+// bodyQuery returns the `query` field of the body, without encoding the whole body with its bind parameters.
+func bodyQuery(body interface{}) (string, bool) {
+	switch b := body.(type) {
+	case nil:
+		return "", false
+	case map[string]interface{}:
+		query, ok := b["query"].(string)
+		return query, ok && query != ""
+	case []byte:
+		var q struct {
+			Query string `json:"query"`
+		}
+		if err := json.Unmarshal(b, &q); err != nil {
+			return "", false
+		}
+
+		return q.Query, q.Query != ""
+	}
+
+	return structQuery(reflect.ValueOf(body))
+}
+
+// structQuery returns the field of the struct with the JSON name `query`. The embedded structs are searched too.
+func structQuery(v reflect.Value) (string, bool) {
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+
+		if f.Anonymous && name == "" {
+			if query, ok := structQuery(v.Field(i)); ok {
+				return query, true
+			}
+			continue
+		}
+
+		if name == "query" && f.Type.Kind() == reflect.String {
+			query := v.Field(i).String()
+			return query, query != ""
+		}
+	}
+
+	return "", false
+}
+
+// injectTraceContext sets the W3C trace context headers.
+func injectTraceContext(carrier TextMapCarrier, sc SpanContext) {
+	if !sc.IsValid() {
+		return
+	}
+
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+
+	carrier.Set("traceparent", "00-"+hex.EncodeToString(sc.TraceID[:])+"-"+hex.EncodeToString(sc.SpanID[:])+"-"+flags)
+	if sc.TraceState != "" {
+		carrier.Set("tracestate", sc.TraceState)
+	}
+}
+
+// endpointAddress returns the host and the port of the endpoint.
+func endpointAddress(endpoint string) (string, int, bool) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return "", 0, false
+	}
+
+	host, portValue, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		return u.Host, 0, false
+	}
+
+	port, err := strconv.Atoi(portValue)
+	if err != nil {
+		return host, 0, false
+	}
+
+	return host, port, true
+}
+
+// RedactQuery replaces the string and the number literals of the AQL query with `?` and removes the comments.
+func RedactQuery(query string) string {
+	var b strings.Builder
+	b.Grow(len(query))
+
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"':
+			// The string literal is skipped with the escaped characters.
+			for i++; i < len(query) && query[i] != c; i++ {
+				if query[i] == '\\' {
+					i++
+				}
+			}
+			b.WriteByte('?')
+		case c == '`':
+			// The quoted name is kept.
+			end := strings.IndexByte(query[i+1:], '`')
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+2])
+			i += end + 1
+		case c == '/' && i+1 < len(query) && query[i+1] == '/':
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				return b.String()
+			}
+			b.WriteByte('\n')
+			i += end
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return b.String()
+			}
+			b.WriteByte(' ')
+			i += end + 3
+		case c >= '0' && c <= '9' && !isQueryNameByte(query, i-1):
+			for i+1 < len(query) && (isQueryNameByte(query, i+1) || query[i+1] == '.' && i+2 < len(query) &&
+				query[i+2] >= '0' && query[i+2] <= '9') {
+				i++
+			}
+			b.WriteByte('?')
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// isQueryNameByte returns true when the byte at the index can be a part of a name or a number.
+func isQueryNameByte(query string, i int) bool {
+	if i < 0 || i >= len(query) {
+		return false
+	}
+
+	c := query[i]
+	return c == '_' || c == '@' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
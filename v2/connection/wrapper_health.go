@@ -0,0 +1,130 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// healthProbeTimeout is the timeout of the `/_api/version` request which probes an endpoint.
+const healthProbeTimeout = 5 * time.Second
+
+// NewHealthCheckWrapper returns the connection which reports the results of the requests to the endpoint
+// of the connection, when it is created with NewHealthEndpoints.
+// The endpoints which cool-down period has ended are probed with `/_api/version` in the background.
+// The request is treated as failed when it returns a network error or a server error (5xx).
+func NewHealthCheckWrapper(conn Connection) Connection {
+	return &healthCheckWrapper{
+		Connection: conn,
+	}
+}
+
+type healthCheckWrapper struct {
+	Connection
+}
+
+func (w *healthCheckWrapper) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	start := time.Now()
+	resp, err := w.Connection.Do(ctx, request, output, allowedStatusCodes...)
+	w.report(request, resp, err, time.Since(start))
+
+	return resp, err
+}
+
+// Stream performs HTTP request.
+// It returns the response and body reader to read the data from there.
+// The caller is responsible to free the response body.
+func (w *healthCheckWrapper) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	start := time.Now()
+	resp, body, err := w.Connection.Stream(ctx, request)
+	w.report(request, resp, err, time.Since(start))
+
+	return resp, body, err
+}
+
+// report reports the result of the request and starts the probes of the endpoints which cool-down period has ended.
+func (w *healthCheckWrapper) report(request Request, resp Response, err error, latency time.Duration) {
+	endpoint, ok := w.GetEndpoint().(HealthEndpoint)
+	if !ok {
+		return
+	}
+
+	if failed, ok := isHealthFailure(resp, err); ok {
+		ep := request.Endpoint()
+		if resp != nil && resp.Endpoint() != "" {
+			ep = resp.Endpoint()
+		}
+
+		endpoint.Report(ep, latency, failed)
+	}
+
+	prober, ok := endpoint.(healthProber)
+	if !ok {
+		return
+	}
+
+	for _, ep := range prober.dueProbes() {
+		go w.probe(prober, ep)
+	}
+}
+
+// probe sends the `/_api/version` request to the endpoint and reports its result.
+func (w *healthCheckWrapper) probe(prober healthProber, ep string) {
+	ctx, cancel := context.WithTimeout(context.Background(), healthProbeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	resp, err := CallWithEndpoint(ctx, w.Connection, ep, http.MethodGet, NewUrl("_api", "version"), nil)
+
+	prober.probed(ep, time.Since(start), err != nil || resp.Code() != http.StatusOK)
+}
+
+// isHealthFailure returns true when the request has failed because of the endpoint.
+// The second value is false when the result says nothing about the health of the endpoint,
+// e.g. when the request has been canceled.
+func isHealthFailure(resp Response, err error) (bool, bool) {
+	if err == nil {
+		return resp != nil && resp.Code() >= http.StatusInternalServerError, true
+	}
+
+	if shared.IsCanceled(err) {
+		return false, false
+	}
+
+	// The endpoint which does not respond in time is treated as unhealthy.
+	if IsNetworkError(err) || errors.Is(err, context.DeadlineExceeded) {
+		return true, true
+	}
+
+	var arangoErr shared.ArangoError
+	if errors.As(err, &arangoErr) {
+		return arangoErr.Code >= http.StatusInternalServerError, true
+	}
+
+	return false, false
+}
@@ -0,0 +1,411 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// RetryPolicy describes when and how often a failed request is repeated.
+// The zero values of the fields are replaced with the defaults, so RetryPolicy{} is a valid policy.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one. The default is 5.
+	MaxAttempts int
+	// MaxElapsedTime is the maximum time since the first attempt, after which the request is not repeated.
+	// The default is 30 seconds.
+	MaxElapsedTime time.Duration
+
+	// InitialInterval is the delay before the first retry. The default is 100 milliseconds.
+	InitialInterval time.Duration
+	// MaxInterval is the maximum delay between the attempts. The default is 5 seconds.
+	MaxInterval time.Duration
+	// Multiplier is the factor by which the delay grows after each attempt. The default is 2.
+	Multiplier float64
+	// Jitter is the fraction of the delay, by which the delay is randomly changed in both directions,
+	// so the clients do not repeat their requests at the same time. The default is 0.2.
+	Jitter float64
+
+	// RetryOnCodes are the HTTP status codes of the responses which are retried.
+	// The default is 503 (service unavailable).
+	RetryOnCodes []int
+	// RetryOnErrorNums are the ArangoDB error numbers of the responses which are retried.
+	// The default is 1200 (write-write conflict), 1004 (read-only), 1478 (cluster backend unavailable)
+	// and 503 (service unavailable).
+	RetryOnErrorNums []int
+	// NotAppliedErrorNums are the ArangoDB error numbers which guarantee that the operation has not been applied,
+	// so the requests which are not idempotent are retried too, when the error number is in RetryOnErrorNums.
+	// The default is 1200 and 1004.
+	NotAppliedErrorNums []int
+
+	// Idempotent returns true when the request can be repeated without changing the result.
+	// The requests which are not idempotent are retried only for the NotAppliedErrorNums errors,
+	// because they might have been applied when the connection or the server fails.
+	// The default treats GET, HEAD and OPTIONS requests as idempotent.
+	// It can be overridden for a single request with WithIdempotent.
+	Idempotent func(method, url string) bool
+
+	// OnRetry is called before the delay preceding the next attempt, e.g. to count the retries.
+	OnRetry func(attempt RetryAttempt)
+	// OnGiveUp is called when the request could be retried, but the attempts or the time are exhausted.
+	OnGiveUp func(attempt RetryAttempt)
+}
+
+// RetryAttempt describes the failed attempt of a request.
+type RetryAttempt struct {
+	// Attempt is the number of the failed attempt, starting from 1.
+	Attempt int
+	Method  string
+	URL     string
+	// Response is the response of the failed attempt. It is nil when the request has failed without a response.
+	Response Response
+	// Err is the error of the failed attempt, or the ArangoDB error returned in the response.
+	Err error
+	// Delay is the time before the next attempt.
+	Delay time.Duration
+	// Elapsed is the time since the first attempt.
+	Elapsed time.Duration
+}
+
+func (r RetryPolicy) withDefaults() RetryPolicy {
+	if r.MaxAttempts <= 0 {
+		r.MaxAttempts = 5
+	}
+	if r.MaxElapsedTime <= 0 {
+		r.MaxElapsedTime = 30 * time.Second
+	}
+	if r.InitialInterval <= 0 {
+		r.InitialInterval = 100 * time.Millisecond
+	}
+	if r.MaxInterval <= 0 {
+		r.MaxInterval = 5 * time.Second
+	}
+	if r.Multiplier < 1 {
+		r.Multiplier = 2
+	}
+	if r.Jitter <= 0 || r.Jitter > 1 {
+		r.Jitter = 0.2
+	}
+	if r.RetryOnCodes == nil {
+		r.RetryOnCodes = []int{http.StatusServiceUnavailable}
+	}
+	if r.RetryOnErrorNums == nil {
+		r.RetryOnErrorNums = []int{shared.ErrArangoConflict, shared.ErrArangoReadOnly, shared.ErrClusterBackendUnavailable,
+			shared.ErrHttpServiceUnavailable}
+	}
+	if r.NotAppliedErrorNums == nil {
+		r.NotAppliedErrorNums = []int{shared.ErrArangoConflict, shared.ErrArangoReadOnly}
+	}
+	if r.Idempotent == nil {
+		r.Idempotent = isIdempotentMethod
+	}
+
+	return r
+}
+
+// isIdempotentMethod returns true for the methods which do not change the data.
+// PUT and DELETE requests are not treated as idempotent, because e.g. `PUT /_api/job/{id}` removes the job result.
+func isIdempotentMethod(method, _ string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// delay returns the randomized delay before the attempt which follows the given failed attempt.
+func (r RetryPolicy) delay(attempt int) time.Duration {
+	interval := float64(r.InitialInterval) * math.Pow(r.Multiplier, float64(attempt-1))
+	if interval > float64(r.MaxInterval) {
+		interval = float64(r.MaxInterval)
+	}
+
+	return time.Duration(interval * (1 + r.Jitter*(2*rand.Float64()-1)))
+}
+
+// NewRetryPolicyWrapper returns the Wrapper which repeats the failed requests according to the policy.
+// The requests are repeated with the exponential backoff, when they fail with a network error,
+// or when the response has one of the status codes or the ArangoDB error numbers of the policy.
+// The request which has failed with a network error is sent again to the endpoint chosen by the connection,
+// unless its endpoint has been provided with NewRequestWithEndpoint.
+// The requests with an io.Reader body are not retried, because the body can be read only once.
+func NewRetryPolicyWrapper(policy RetryPolicy) Wrapper {
+	return func(c Connection) Connection {
+		return &retryPolicyWrapper{
+			Connection: c,
+			policy:     policy.withDefaults(),
+		}
+	}
+}
+
+type retryPolicyWrapper struct {
+	Connection
+
+	policy RetryPolicy
+}
+
+// Do performs the request with Do of the wrapped connection.
+// The output of each attempt is decoded into a copy of the output, so the output is populated only from the last attempt,
+// and the fields which have been set by the caller are kept, when the response does not contain them.
+func (w *retryPolicyWrapper) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	if !w.retryable(request) {
+		return w.Connection.Do(ctx, request, output, allowedStatusCodes...)
+	}
+
+	var resp Response
+	var err error
+	var target interface{}
+	w.retry(ctx, request, func(req Request) (Response, error, error) {
+		target = newRetryOutput(output)
+		resp, err = w.Connection.Do(ctx, req, target, allowedStatusCodes...)
+
+		if err != nil {
+			if shared.IsArangoError(err) {
+				return resp, err, nil
+			}
+
+			return resp, nil, err
+		}
+
+		if resp.Code() < http.StatusBadRequest {
+			return resp, nil, nil
+		}
+
+		var respStruct shared.Response
+		_ = w.Decoder(resp.Content()).Reencode(target, &respStruct)
+		return resp, respStruct.AsArangoErrorWithCode(resp.Code()), nil
+	})
+
+	if output != nil && target != output {
+		reflect.ValueOf(output).Elem().Set(reflect.ValueOf(target).Elem())
+	}
+
+	return resp, err
+}
+
+// Stream performs HTTP request.
+// It returns the response and body reader to read the data from there.
+// The caller is responsible to free the response body.
+func (w *retryPolicyWrapper) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	if !w.retryable(request) {
+		return w.Connection.Stream(ctx, request)
+	}
+
+	var resp Response
+	var body io.ReadCloser
+	var err error
+	w.retry(ctx, request, func(req Request) (Response, error, error) {
+		resp, body, err = w.Connection.Stream(ctx, req)
+		if err != nil {
+			return resp, nil, err
+		}
+
+		if resp.Code() < http.StatusBadRequest {
+			return resp, nil, nil
+		}
+
+		// The error response is read, so its error number can be checked.
+		var data []byte
+		data, err = readBody(body)
+		if err != nil {
+			resp, body = nil, nil
+			return nil, nil, err
+		}
+		body = io.NopCloser(bytes.NewReader(data))
+
+		var respStruct shared.Response
+		_ = w.Decoder(resp.Content()).Decode(bytes.NewReader(data), &respStruct)
+		return resp, respStruct.AsArangoErrorWithCode(resp.Code()), nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, body, nil
+}
+
+// retry performs the attempts until the result of the attempt is not retried.
+// The attempt returns the response, the ArangoDB error of the response, and the error of the request.
+// The result of the last attempt is kept by the caller.
+func (w *retryPolicyWrapper) retry(ctx context.Context, request Request, attempt func(req Request) (Response, error, error)) {
+	idempotent, ok := IsIdempotent(ctx)
+	if !ok {
+		idempotent = w.policy.Idempotent(request.Method(), request.URL())
+	}
+
+	start := time.Now()
+	for n := 1; ; n++ {
+		resp, arangoErr, err := attempt(request)
+
+		retry := false
+		if err != nil {
+			retry = idempotent && IsNetworkError(err)
+		} else if arangoErr != nil {
+			retry = w.retryResponse(arangoErr, idempotent)
+		}
+
+		if !retry {
+			return
+		}
+
+		info := RetryAttempt{
+			Attempt:  n,
+			Method:   request.Method(),
+			URL:      request.URL(),
+			Response: resp,
+			Err:      err,
+			Delay:    w.policy.delay(n),
+			Elapsed:  time.Since(start),
+		}
+		if arangoErr != nil {
+			info.Err = arangoErr
+		}
+
+		if n >= w.policy.MaxAttempts || info.Elapsed+info.Delay > w.policy.MaxElapsedTime {
+			if w.policy.OnGiveUp != nil {
+				info.Delay = 0
+				w.policy.OnGiveUp(info)
+			}
+
+			return
+		}
+
+		if w.policy.OnRetry != nil {
+			w.policy.OnRetry(info)
+		}
+
+		timer := time.NewTimer(info.Delay)
+		select {
+		case <-contextOrBackground(ctx).Done():
+			// The result of the last attempt is returned, because it is better than the context error.
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if err != nil {
+			// The server might be down, so the endpoint is chosen again.
+			request = w.retarget(request)
+		}
+	}
+}
+
+// retarget returns the copy of the request which is sent to the endpoint chosen again by the connection.
+// The request is returned as it is when its endpoint has been provided, e.g. for the cursor batches.
+func (w *retryPolicyWrapper) retarget(request Request) Request {
+	r, ok := request.(*httpRequest)
+	if !ok || r.pinned {
+		return request
+	}
+
+	base, err := url.Parse(r.endpoint)
+	if err != nil {
+		return request
+	}
+
+	newRequest, err := w.Connection.NewRequest(r.method, strings.TrimPrefix(r.url.Path, base.Path))
+	if err != nil {
+		return request
+	}
+
+	n, ok := newRequest.(*httpRequest)
+	if !ok {
+		return request
+	}
+
+	n.url.RawQuery = r.url.RawQuery
+	n.url.Fragment = r.url.Fragment
+	n.body = r.body
+	for key, value := range r.headers {
+		n.AddHeader(key, value)
+	}
+
+	return n
+}
+
+// newRetryOutput returns the copy of the output, into which the output of an attempt is decoded.
+// The output is used as it is when it is not a pointer.
+func newRetryOutput(output interface{}) interface{} {
+	if output == nil {
+		// The error number of the response is still needed.
+		return &shared.Response{}
+	}
+
+	v := reflect.ValueOf(output)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return output
+	}
+
+	target := reflect.New(v.Type().Elem())
+	target.Elem().Set(v.Elem())
+
+	return target.Interface()
+}
+
+// retryable returns false when the request can not be sent again.
+func (w *retryPolicyWrapper) retryable(request Request) bool {
+	if r, ok := request.(*httpRequest); ok {
+		if _, isReader := r.body.(io.Reader); isReader {
+			return false
+		}
+	}
+
+	return true
+}
+
+// retryResponse returns true when the response with the error should be retried.
+// The response might not be available when it is handled by the wrapped connection, so the status code is taken from the error.
+func (w *retryPolicyWrapper) retryResponse(err error, idempotent bool) bool {
+	retry := shared.IsArangoErrorWithErrorNum(err, w.policy.RetryOnErrorNums...)
+	for _, c := range w.policy.RetryOnCodes {
+		if shared.IsArangoErrorWithCode(err, c) {
+			retry = true
+		}
+	}
+
+	if !retry {
+		return false
+	}
+
+	return idempotent || shared.IsArangoErrorWithErrorNum(err, w.policy.NotAppliedErrorNums...)
+}
+
+// readBody reads and closes the body.
+func readBody(body io.ReadCloser) ([]byte, error) {
+	if body == nil {
+		return nil, nil
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
@@ -0,0 +1,340 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// retryTestServer responds with the scripted responses, and then with 200,
+// or with 202 when the request is sent with the async header.
+type retryTestServer struct {
+	*httptest.Server
+
+	lock      sync.Mutex
+	responses []retryTestResponse
+	calls     int
+}
+
+type retryTestResponse struct {
+	code     int
+	errorNum int
+	// drop closes the connection without a response.
+	drop bool
+}
+
+func newRetryTestServer(t *testing.T, responses ...retryTestResponse) *retryTestServer {
+	s := &retryTestServer{responses: responses}
+	s.Server = newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		s.calls++
+		response := retryTestResponse{code: http.StatusOK}
+		if len(s.responses) > 0 {
+			response = s.responses[0]
+			s.responses = s.responses[1:]
+		}
+		s.lock.Unlock()
+
+		if response.drop {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+			return
+		}
+
+		if response.code == http.StatusOK && r.Header.Get(ArangoHeaderAsyncKey) == ArangoHeaderAsyncValue {
+			w.Header().Set(ArangoHeaderAsyncIDKey, "1")
+			writeTestResponse(w, http.StatusAccepted, nil)
+			return
+		}
+
+		if response.code == http.StatusOK {
+			writeTestResponse(w, http.StatusOK, map[string]interface{}{"result": "ok"})
+			return
+		}
+
+		writeTestError(w, response.code, response.errorNum)
+	}))
+
+	return s
+}
+
+func (s *retryTestServer) callsCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.calls
+}
+
+func newRetryTestConnection(s *retryTestServer, policy RetryPolicy) Connection {
+	return NewRetryPolicyWrapper(policy)(newTestConnection(NewRoundRobinEndpoints([]string{s.URL})))
+}
+
+func TestRetryPolicyWrapper(t *testing.T) {
+	unavailable := retryTestResponse{code: http.StatusServiceUnavailable, errorNum: shared.ErrHttpServiceUnavailable}
+	conflict := retryTestResponse{code: http.StatusConflict, errorNum: shared.ErrArangoConflict}
+	notFound := retryTestResponse{code: http.StatusNotFound, errorNum: shared.ErrArangoDocumentNotFound}
+
+	testCases := map[string]struct {
+		method     string
+		ctx        func() context.Context
+		responses  []retryTestResponse
+		policy     RetryPolicy
+		wantCalls  int
+		wantCode   int
+		wantRetry  int
+		wantGiveUp bool
+	}{
+		"GET is retried on 503": {
+			method:    http.MethodGet,
+			responses: []retryTestResponse{unavailable, unavailable},
+			wantCalls: 3,
+			wantCode:  http.StatusOK,
+			wantRetry: 2,
+		},
+		"GET is retried on network error": {
+			method:    http.MethodGet,
+			responses: []retryTestResponse{{drop: true}},
+			wantCalls: 2,
+			wantCode:  http.StatusOK,
+			wantRetry: 1,
+		},
+		"GET is not retried on 404": {
+			method:    http.MethodGet,
+			responses: []retryTestResponse{notFound},
+			wantCalls: 1,
+			wantCode:  http.StatusNotFound,
+		},
+		"POST is not retried on 503": {
+			method:    http.MethodPost,
+			responses: []retryTestResponse{unavailable},
+			wantCalls: 1,
+			wantCode:  http.StatusServiceUnavailable,
+		},
+		"POST is retried on write-write conflict": {
+			method:    http.MethodPost,
+			responses: []retryTestResponse{conflict},
+			wantCalls: 2,
+			wantCode:  http.StatusOK,
+			wantRetry: 1,
+		},
+		"idempotent POST is retried on 503": {
+			method: http.MethodPost,
+			ctx: func() context.Context {
+				return WithIdempotent(context.Background(), true)
+			},
+			responses: []retryTestResponse{unavailable},
+			wantCalls: 2,
+			wantCode:  http.StatusOK,
+			wantRetry: 1,
+		},
+		"attempts are exhausted": {
+			method:     http.MethodGet,
+			responses:  []retryTestResponse{unavailable, unavailable, unavailable},
+			policy:     RetryPolicy{MaxAttempts: 2},
+			wantCalls:  2,
+			wantCode:   http.StatusServiceUnavailable,
+			wantRetry:  1,
+			wantGiveUp: true,
+		},
+		"elapsed time is exhausted": {
+			method:     http.MethodGet,
+			responses:  []retryTestResponse{unavailable, unavailable},
+			policy:     RetryPolicy{InitialInterval: time.Second, MaxElapsedTime: 100 * time.Millisecond},
+			wantCalls:  1,
+			wantCode:   http.StatusServiceUnavailable,
+			wantGiveUp: true,
+		},
+		"custom error numbers": {
+			method:    http.MethodGet,
+			responses: []retryTestResponse{unavailable, notFound},
+			policy:    RetryPolicy{RetryOnCodes: []int{}, RetryOnErrorNums: []int{shared.ErrArangoDocumentNotFound}},
+			wantCalls: 1,
+			wantCode:  http.StatusServiceUnavailable,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := newRetryTestServer(t, tc.responses...)
+
+			var retries []RetryAttempt
+			giveUp := false
+			policy := tc.policy
+			if policy.InitialInterval == 0 {
+				policy.InitialInterval = time.Millisecond
+			}
+			policy.OnRetry = func(attempt RetryAttempt) {
+				retries = append(retries, attempt)
+			}
+			policy.OnGiveUp = func(attempt RetryAttempt) {
+				giveUp = true
+			}
+
+			ctx := context.Background()
+			if tc.ctx != nil {
+				ctx = tc.ctx()
+			}
+
+			var output map[string]interface{}
+			resp, err := Call(ctx, newRetryTestConnection(server, policy), tc.method, "_api/document/c/1", &output)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantCode, resp.Code())
+			assert.Equal(t, tc.wantCalls, server.callsCount())
+			assert.Equal(t, tc.wantGiveUp, giveUp)
+			require.Len(t, retries, tc.wantRetry)
+			for i, r := range retries {
+				assert.Equal(t, i+1, r.Attempt)
+				assert.Equal(t, tc.method, r.Method)
+				assert.True(t, strings.HasSuffix(r.URL, "/_api/document/c/1"))
+				assert.Error(t, r.Err)
+			}
+
+			if tc.wantCode == http.StatusOK {
+				assert.Equal(t, "ok", output["result"])
+			} else {
+				// The body of the last response is still available.
+				assert.Equal(t, float64(tc.wantCode), output["code"])
+			}
+		})
+	}
+}
+
+func TestRetryPolicyWrapper_Do(t *testing.T) {
+	server := newRetryTestServer(t, retryTestResponse{code: http.StatusServiceUnavailable, errorNum: 503})
+	conn := newRetryTestConnection(server, RetryPolicy{MaxAttempts: 1})
+
+	_, err := CallWithChecks(context.Background(), conn, http.MethodGet, "_api/version", nil, []int{http.StatusOK})
+	require.Error(t, err)
+	assert.True(t, shared.IsArangoErrorWithErrorNum(err, 503))
+
+	_, err = CallWithChecks(context.Background(), conn, http.MethodGet, "_api/version", nil, []int{http.StatusOK})
+	require.NoError(t, err)
+}
+
+func TestRetryPolicyWrapper_DoPopulatedOutput(t *testing.T) {
+	server := newRetryTestServer(t, retryTestResponse{code: http.StatusServiceUnavailable, errorNum: 503})
+	conn := newRetryTestConnection(server, RetryPolicy{InitialInterval: time.Millisecond})
+
+	output := struct {
+		ID     string `json:"id"`
+		Result string `json:"result"`
+	}{ID: "cursor"}
+	_, err := CallWithChecks(context.Background(), conn, http.MethodGet, "_api/version", &output, []int{http.StatusOK})
+	require.NoError(t, err)
+
+	// The field, which is not in the response, is kept.
+	assert.Equal(t, "cursor", output.ID)
+	assert.Equal(t, "ok", output.Result)
+	assert.Equal(t, 2, server.callsCount())
+}
+
+func TestRetryPolicyWrapper_Async(t *testing.T) {
+	server := newRetryTestServer(t, retryTestResponse{code: http.StatusServiceUnavailable, errorNum: 503})
+	conn := NewRetryPolicyWrapper(RetryPolicy{InitialInterval: time.Millisecond})(
+		NewConnectionAsyncWrapper(newTestConnection(NewRoundRobinEndpoints([]string{server.URL}))))
+
+	_, err := CallGet(WithAsync(context.Background()), conn, "_api/version", nil)
+	require.Error(t, err)
+
+	// The request is sent by the async wrapper, and it is retried after 503.
+	id, ok := IsAsyncJobInProgress(err)
+	require.True(t, ok)
+	assert.Equal(t, "1", id)
+	assert.Equal(t, 2, server.callsCount())
+}
+
+func TestRetryPolicyWrapper_Retarget(t *testing.T) {
+	server := newRetryTestServer(t)
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	conn := NewRetryPolicyWrapper(RetryPolicy{InitialInterval: time.Millisecond})(
+		newTestConnection(NewRoundRobinEndpoints([]string{down.URL, server.URL})))
+
+	var output map[string]interface{}
+	_, err := CallWithChecks(context.Background(), conn, http.MethodGet, "_api/version", &output, []int{http.StatusOK})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", output["result"])
+	assert.Equal(t, 1, server.callsCount())
+
+	// The request with the provided endpoint is not sent to another server.
+	req, err := conn.NewRequestWithEndpoint(down.URL, http.MethodGet, "_api/version")
+	require.NoError(t, err)
+	_, err = conn.Do(context.Background(), req, nil, http.StatusOK)
+	require.Error(t, err)
+	assert.True(t, IsNetworkError(err))
+	assert.Equal(t, 1, server.callsCount())
+}
+
+func TestRetryPolicyWrapper_Canceled(t *testing.T) {
+	server := newRetryTestServer(t, retryTestResponse{code: http.StatusServiceUnavailable, errorNum: 503})
+	conn := newRetryTestConnection(server, RetryPolicy{InitialInterval: time.Hour, MaxElapsedTime: 2 * time.Hour})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	resp, body, err := CallStream(ctx, conn, http.MethodGet, "_api/version")
+	require.NoError(t, err)
+	defer body.Close()
+
+	// The last response is returned when the context is done during the delay.
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code())
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"errorNum":503`)
+	assert.Equal(t, 1, server.callsCount())
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	policy := RetryPolicy{
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     time.Second,
+		Jitter:          0.1,
+	}.withDefaults()
+
+	for attempt, expected := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		4: 800 * time.Millisecond,
+		5: time.Second,
+		9: time.Second,
+	} {
+		for i := 0; i < 10; i++ {
+			delay := policy.delay(attempt)
+			assert.InDelta(t, float64(expected), float64(delay), float64(expected)*0.1, "attempt %d", attempt)
+		}
+	}
+}
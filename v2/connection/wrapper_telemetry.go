@@ -0,0 +1,243 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+// NewTelemetryWrapper returns the Wrapper which instruments the requests with the spans and the metrics.
+// A client span is created for each request, and its context is propagated to the server with the request headers.
+// The span and the duration of a streamed request end when the response body is closed.
+// The request body is encoded once more to measure its size only when the request size histogram is recorded.
+func NewTelemetryWrapper(config TelemetryConfiguration) Wrapper {
+	telemetry := NewTelemetry(config)
+
+	return func(c Connection) Connection {
+		return &telemetryWrapper{
+			Connection: c,
+			telemetry:  telemetry,
+		}
+	}
+}
+
+type telemetryWrapper struct {
+	Connection
+
+	telemetry *Telemetry
+}
+
+// Do performs the request with Do of the wrapped connection.
+// The response size is taken from the Content-Length header of the response.
+func (w *telemetryWrapper) Do(ctx context.Context, request Request, output interface{}, allowedStatusCodes ...int) (Response, error) {
+	ctx, o := w.telemetry.Start(ctx, w.telemetryRequest(request))
+
+	resp, err := w.Connection.Do(ctx, request, output, allowedStatusCodes...)
+
+	var arangoErr shared.ArangoError
+	isArangoErr := errors.As(err, &arangoErr) && arangoErr.HasError
+
+	if resp != nil {
+		o.SetResponse(resp.Code(), resp.Endpoint())
+	} else if isArangoErr {
+		o.SetResponse(arangoErr.Code, "")
+	}
+
+	switch {
+	case isArangoErr:
+		o.SetResponseError(arangoErr.Code, arangoErr.ErrorNum, arangoErr)
+		o.End(responseSize(resp), nil)
+	case err != nil:
+		if _, ok := IsAsyncJobInProgress(err); ok {
+			// The job is accepted by the server, so the request has not failed.
+			o.End(-1, nil)
+		} else {
+			o.End(-1, err)
+		}
+	default:
+		if resp.Code() >= http.StatusBadRequest && output != nil {
+			var respStruct shared.ResponseStruct
+			if w.Decoder(resp.Content()).Reencode(output, &respStruct) == nil {
+				arangoErr = respStruct.AsArangoErrorWithCode(resp.Code())
+				o.SetResponseError(resp.Code(), arangoErr.ErrorNum, arangoErr)
+			}
+		}
+		o.End(responseSize(resp), nil)
+	}
+
+	return resp, err
+}
+
+// Stream performs HTTP request.
+// It returns the response and body reader to read the data from there.
+// The caller is responsible to free the response body.
+func (w *telemetryWrapper) Stream(ctx context.Context, request Request) (Response, io.ReadCloser, error) {
+	ctx, o := w.telemetry.Start(ctx, w.telemetryRequest(request))
+
+	resp, body, err := w.Connection.Stream(ctx, request)
+	if err != nil {
+		o.End(-1, err)
+		return resp, body, err
+	}
+
+	o.SetResponse(resp.Code(), resp.Endpoint())
+
+	if resp.Code() >= http.StatusBadRequest && body != nil {
+		// The error response is read, so its error number can be recorded.
+		data, err := readBody(body)
+		if err != nil {
+			o.End(-1, err)
+			return nil, nil, err
+		}
+		body = io.NopCloser(bytes.NewReader(data))
+
+		var respStruct shared.Response
+		_ = w.Decoder(resp.Content()).Decode(bytes.NewReader(data), &respStruct)
+		arangoErr := respStruct.AsArangoErrorWithCode(resp.Code())
+		o.SetResponseError(resp.Code(), arangoErr.ErrorNum, arangoErr)
+	}
+
+	if body == nil {
+		o.End(0, nil)
+		return resp, nil, nil
+	}
+
+	return resp, &telemetryBody{body: body, operation: o}, nil
+}
+
+// telemetryRequest returns the description of the request for the telemetry.
+func (w *telemetryWrapper) telemetryRequest(request Request) TelemetryRequest {
+	t := TelemetryRequest{
+		Method: request.Method(),
+		URL:    request.URL(),
+		Header: requestCarrier{request: request},
+	}
+
+	if r, ok := request.(*httpRequest); ok {
+		t.Body = r.body
+		t.Size = func() (int64, bool) {
+			return w.bodySize(r)
+		}
+	}
+
+	return t
+}
+
+// bodySize returns the size of the encoded request body.
+func (w *telemetryWrapper) bodySize(r *httpRequest) (int64, bool) {
+	switch body := r.body.(type) {
+	case nil:
+		return 0, true
+	case []byte:
+		return int64(len(body)), true
+	case io.Reader:
+		// The body can be read only once.
+		return 0, false
+	}
+
+	contentType, _ := r.GetHeader(ContentType)
+	counter := &countingWriter{}
+	if err := w.Decoder(contentType).Encode(counter, r.body); err != nil {
+		return 0, false
+	}
+
+	return counter.size, true
+}
+
+// responseSize returns the size of the response body from its Content-Length header, or -1 when it is unknown.
+func responseSize(resp Response) int64 {
+	if resp == nil {
+		return -1
+	}
+
+	size, err := strconv.ParseInt(resp.Header("Content-Length"), 10, 64)
+	if err != nil {
+		return -1
+	}
+
+	return size
+}
+
+// requestCarrier sets the propagated fields as the headers of the request.
+type requestCarrier struct {
+	request Request
+}
+
+func (c requestCarrier) Get(key string) string {
+	value, _ := c.request.GetHeader(key)
+	return value
+}
+
+func (c requestCarrier) Set(key, value string) {
+	c.request.AddHeader(key, value)
+}
+
+func (c requestCarrier) Keys() []string {
+	r, ok := c.request.(*httpRequest)
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(r.headers))
+	for key := range r.headers {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// telemetryBody ends the telemetry operation when the response body is closed.
+type telemetryBody struct {
+	body      io.ReadCloser
+	operation *TelemetryOperation
+	size      int64
+}
+
+func (t *telemetryBody) Read(p []byte) (int, error) {
+	n, err := t.body.Read(p)
+	t.size += int64(n)
+
+	return n, err
+}
+
+func (t *telemetryBody) Close() error {
+	err := t.body.Close()
+	t.operation.End(t.size, nil)
+
+	return err
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct {
+	size int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.size += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,284 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package connection
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (s *testSpan) SpanContext() SpanContext {
+	return SpanContext{
+		TraceID:    [16]byte{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:     [8]byte{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		Sampled:    true,
+		TraceState: "vendor=value",
+	}
+}
+
+func (s *testSpan) SetAttributes(attributes ...Attribute) {
+	for _, a := range attributes {
+		s.attributes[a.Key] = a.Value
+	}
+}
+
+func (s *testSpan) SetError(err error) {
+	s.err = err
+}
+
+func (s *testSpan) End() {
+	s.ended = true
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span) {
+	s := &testSpan{name: name, attributes: map[string]interface{}{}}
+	s.SetAttributes(attributes...)
+	t.spans = append(t.spans, s)
+
+	return ctx, s
+}
+
+type testHistogram struct {
+	lock   sync.Mutex
+	values []float64
+	codes  []interface{}
+}
+
+func (h *testHistogram) Record(_ context.Context, value float64, attributes ...Attribute) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.values = append(h.values, value)
+	for _, a := range attributes {
+		if a.Key == AttributeHTTPStatusCode {
+			h.codes = append(h.codes, a.Value)
+		}
+	}
+}
+
+type testMeter map[string]*testHistogram
+
+func (m testMeter) Histogram(name, _, _ string) Histogram {
+	m[name] = &testHistogram{}
+	return m[name]
+}
+
+func TestTelemetryWrapper(t *testing.T) {
+	var traceParent, traceState string
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent, traceState = r.Header.Get("traceparent"), r.Header.Get("tracestate")
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/_api/cursor"):
+			writeTestResponse(w, http.StatusCreated, map[string]interface{}{"result": []int{1}})
+		case strings.HasSuffix(r.URL.Path, "/_api/document/users/missing"):
+			writeTestError(w, http.StatusNotFound, 1202)
+		default:
+			writeTestError(w, http.StatusServiceUnavailable, 503)
+		}
+	}))
+
+	tracer := &testTracer{}
+	meter := testMeter{}
+	conn := NewTelemetryWrapper(TelemetryConfiguration{
+		Tracer:    tracer,
+		Meter:     meter,
+		QueryText: QueryTextRedacted,
+	})(newTestConnection(NewRoundRobinEndpoints([]string{server.URL})))
+
+	t.Run("query", func(t *testing.T) {
+		var output map[string]interface{}
+		body := map[string]interface{}{"query": "FOR u IN users FILTER u.name == 'secret' LIMIT 10 RETURN u"}
+		resp, err := CallPost(context.Background(), conn, "_db/mydb/_api/cursor", &output, body)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.Code())
+		assert.Equal(t, []interface{}{float64(1)}, output["result"])
+
+		require.Len(t, tracer.spans, 1)
+		span := tracer.spans[0]
+		assert.True(t, span.ended)
+		assert.NoError(t, span.err)
+		assert.Equal(t, "POST /_api/cursor", span.name)
+		assert.Equal(t, "arangodb", span.attributes[AttributeDBSystem])
+		assert.Equal(t, "mydb", span.attributes[AttributeDBName])
+		assert.Equal(t, http.MethodPost, span.attributes[AttributeHTTPMethod])
+		assert.Equal(t, http.StatusCreated, span.attributes[AttributeHTTPStatusCode])
+		assert.Equal(t, "127.0.0.1", span.attributes[AttributeServerAddress])
+		assert.Equal(t, "FOR u IN users FILTER u.name == ? LIMIT ? RETURN u", span.attributes[AttributeDBQueryText])
+
+		assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", traceParent)
+		assert.Equal(t, "vendor=value", traceState)
+
+		assert.Len(t, meter[MetricRequestDuration].values, 1)
+		assert.Equal(t, []interface{}{http.StatusCreated}, meter[MetricRequestDuration].codes)
+		assert.Greater(t, meter[MetricRequestSize].values[0], float64(0))
+		assert.Equal(t, []float64{float64(len(`{"result":[1]}`))}, meter[MetricResponseSize].values)
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		_, err := CallWithChecks(context.Background(), conn, http.MethodGet, "_db/mydb/_api/document/users/missing", nil,
+			[]int{http.StatusOK})
+		require.Error(t, err)
+
+		require.Len(t, tracer.spans, 2)
+		span := tracer.spans[1]
+		assert.Equal(t, "GET /_api/document", span.name)
+		assert.Equal(t, "users", span.attributes[AttributeDBCollection])
+		assert.Equal(t, 1202, span.attributes[AttributeErrorNum])
+		assert.NoError(t, span.err)
+		assert.True(t, span.ended)
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		_, body, err := CallStream(context.Background(), conn, http.MethodGet, "_api/version")
+		require.NoError(t, err)
+		require.NoError(t, body.Close())
+
+		require.Len(t, tracer.spans, 3)
+		span := tracer.spans[2]
+		assert.Equal(t, "_system", span.attributes[AttributeDBName])
+		assert.Equal(t, 503, span.attributes[AttributeErrorNum])
+		assert.Error(t, span.err)
+		assert.True(t, span.ended)
+	})
+}
+
+func TestRedactQuery(t *testing.T) {
+	testCases := map[string]string{
+		"FOR d IN coll RETURN d":                           "FOR d IN coll RETURN d",
+		`FILTER d.name == "it's \"quoted\"" RETURN d`:      "FILTER d.name == ? RETURN d",
+		"FILTER d.a == 'x' && d.b == 1.5e3 RETURN d":       "FILTER d.a == ? && d.b == ? RETURN d",
+		"FOR i IN 1..10 FILTER d.x2 == @value3 RETURN i":   "FOR i IN ?..? FILTER d.x2 == @value3 RETURN i",
+		"FOR d IN `coll 'a'` RETURN d // secret 'comment'": "FOR d IN `coll 'a'` RETURN d ",
+		"RETURN /* the password is 'x' */ LENGTH(@@coll2)": "RETURN   LENGTH(@@coll2)",
+	}
+
+	for query, expected := range testCases {
+		assert.Equal(t, expected, RedactQuery(query), query)
+	}
+}
+
+// countingBody counts how many times it is encoded.
+type countingBody struct {
+	encoded *int32
+}
+
+func (b countingBody) MarshalJSON() ([]byte, error) {
+	atomic.AddInt32(b.encoded, 1)
+	return []byte(`{"query":"RETURN 1"}`), nil
+}
+
+type testPropagator struct{}
+
+func (testPropagator) Inject(ctx context.Context, carrier TextMapCarrier) {
+	carrier.Set("baggage", "user=test")
+}
+
+func TestTelemetryWrapper_Wrapped(t *testing.T) {
+	var headers http.Header
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		w.Header().Set(ArangoHeaderAsyncIDKey, "1")
+		writeTestResponse(w, http.StatusAccepted, nil)
+	}))
+
+	t.Run("async", func(t *testing.T) {
+		tracer := &testTracer{}
+		conn := NewTelemetryWrapper(TelemetryConfiguration{Tracer: tracer, Propagator: testPropagator{}})(
+			NewConnectionAsyncWrapper(newTestConnection(NewRoundRobinEndpoints([]string{server.URL}))))
+
+		_, err := CallGet(WithAsync(context.Background()), conn, "_api/version", nil)
+		_, ok := IsAsyncJobInProgress(err)
+		require.True(t, ok)
+
+		// The request is sent by the async wrapper, with the headers of the propagator.
+		assert.Equal(t, ArangoHeaderAsyncValue, headers.Get(ArangoHeaderAsyncKey))
+		assert.Equal(t, "user=test", headers.Get("baggage"))
+		assert.Empty(t, headers.Get("traceparent"))
+
+		require.Len(t, tracer.spans, 1)
+		assert.True(t, tracer.spans[0].ended)
+		assert.NoError(t, tracer.spans[0].err)
+	})
+
+	t.Run("body encoding", func(t *testing.T) {
+		var encoded int32
+		conn := NewTelemetryWrapper(TelemetryConfiguration{Tracer: &testTracer{}, QueryText: QueryTextFull})(
+			newTestConnection(NewRoundRobinEndpoints([]string{server.URL})))
+
+		_, err := CallPost(context.Background(), conn, "_api/cursor", nil, countingBody{encoded: &encoded})
+		require.NoError(t, err)
+		// The body is encoded only by the connection, when the request size is not recorded.
+		assert.Equal(t, int32(1), atomic.LoadInt32(&encoded))
+
+		meter := testMeter{}
+		conn = NewTelemetryWrapper(TelemetryConfiguration{Meter: meter})(
+			newTestConnection(NewRoundRobinEndpoints([]string{server.URL})))
+
+		_, err = CallPost(context.Background(), conn, "_api/cursor", nil, countingBody{encoded: &encoded})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&encoded))
+		assert.Equal(t, []float64{float64(len(`{"query":"RETURN 1"}` + "\n"))}, meter[MetricRequestSize].values)
+	})
+}
+
+func Test_bodyQuery(t *testing.T) {
+	type options struct {
+		Count bool `json:"count"`
+	}
+	type request struct {
+		Query string `json:"query"`
+	}
+
+	body := struct {
+		*options
+		*request
+	}{request: &request{Query: "RETURN 1"}}
+
+	query, ok := bodyQuery(&body)
+	require.True(t, ok)
+	assert.Equal(t, "RETURN 1", query)
+
+	query, ok = bodyQuery(map[string]interface{}{"query": "RETURN 2", "bindVars": map[string]interface{}{"a": 1}})
+	require.True(t, ok)
+	assert.Equal(t, "RETURN 2", query)
+
+	_, ok = bodyQuery([]int{1})
+	assert.False(t, ok)
+}
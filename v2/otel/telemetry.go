@@ -0,0 +1,194 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+// Package otel adapts the telemetry of the driver connections to OpenTelemetry.
+// It is a separate module, so the driver does not depend on OpenTelemetry.
+//
+// The configuration returned by NewTelemetryConfiguration is used with the wrapper of the V2 connections:
+//
+//	conn = connection.NewTelemetryWrapper(otel.NewTelemetryConfiguration(otel.Configuration{}))(conn)
+//
+// and with the V1 connections:
+//
+//	conn = wrappers.NewTelemetryConnection(conn, otel.NewTelemetryConfiguration(otel.Configuration{}))
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+// InstrumentationName is the name of the tracer and the meter of the driver.
+const InstrumentationName = "github.com/arangodb/go-driver/v2"
+
+// Configuration is the configuration of the OpenTelemetry telemetry.
+type Configuration struct {
+	// TracerProvider provides the tracer of the spans. The global provider is used when it is nil.
+	TracerProvider trace.TracerProvider
+	// MeterProvider provides the meter of the histograms. The global provider is used when it is nil.
+	MeterProvider metric.MeterProvider
+	// Propagator injects the trace context and the baggage into the request headers.
+	// The global propagator is used when it is nil.
+	Propagator propagation.TextMapPropagator
+	// QueryText defines how the text of the AQL queries is recorded in the spans. It is omitted by default.
+	QueryText connection.QueryTextMode
+}
+
+// NewTelemetryConfiguration returns the telemetry configuration, which records the spans and the metrics
+// with the OpenTelemetry providers and propagates the context with the OpenTelemetry propagator.
+func NewTelemetryConfiguration(config Configuration) connection.TelemetryConfiguration {
+	if config.TracerProvider == nil {
+		config.TracerProvider = otel.GetTracerProvider()
+	}
+	if config.MeterProvider == nil {
+		config.MeterProvider = otel.GetMeterProvider()
+	}
+	if config.Propagator == nil {
+		config.Propagator = otel.GetTextMapPropagator()
+	}
+
+	return connection.TelemetryConfiguration{
+		Tracer:     NewTracer(config.TracerProvider.Tracer(InstrumentationName)),
+		Meter:      NewMeter(config.MeterProvider.Meter(InstrumentationName)),
+		Propagator: NewPropagator(config.Propagator),
+		QueryText:  config.QueryText,
+	}
+}
+
+// NewTracer returns the tracer which starts the OpenTelemetry client spans.
+func NewTracer(tracer trace.Tracer) connection.Tracer {
+	return tracerAdapter{tracer: tracer}
+}
+
+type tracerAdapter struct {
+	tracer trace.Tracer
+}
+
+func (t tracerAdapter) Start(ctx context.Context, name string, attributes ...connection.Attribute) (context.Context, connection.Span) {
+	ctx, span := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(keyValues(attributes)...))
+
+	return ctx, spanAdapter{span: span}
+}
+
+type spanAdapter struct {
+	span trace.Span
+}
+
+func (s spanAdapter) SpanContext() connection.SpanContext {
+	sc := s.span.SpanContext()
+
+	return connection.SpanContext{
+		TraceID:    sc.TraceID(),
+		SpanID:     sc.SpanID(),
+		Sampled:    sc.IsSampled(),
+		TraceState: sc.TraceState().String(),
+	}
+}
+
+func (s spanAdapter) SetAttributes(attributes ...connection.Attribute) {
+	s.span.SetAttributes(keyValues(attributes)...)
+}
+
+func (s spanAdapter) SetError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s spanAdapter) End() {
+	s.span.End()
+}
+
+// NewMeter returns the meter which creates the OpenTelemetry histograms.
+func NewMeter(meter metric.Meter) connection.Meter {
+	return meterAdapter{meter: meter}
+}
+
+type meterAdapter struct {
+	meter metric.Meter
+}
+
+func (m meterAdapter) Histogram(name, unit, description string) connection.Histogram {
+	h, err := m.meter.Float64Histogram(name, metric.WithUnit(unit), metric.WithDescription(description))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return histogramAdapter{histogram: h}
+}
+
+type histogramAdapter struct {
+	histogram metric.Float64Histogram
+}
+
+func (h histogramAdapter) Record(ctx context.Context, value float64, attributes ...connection.Attribute) {
+	if h.histogram == nil {
+		return
+	}
+
+	h.histogram.Record(ctx, value, metric.WithAttributes(keyValues(attributes)...))
+}
+
+// NewPropagator returns the propagator which injects the fields of the OpenTelemetry propagator,
+// e.g. the W3C trace context and the baggage.
+func NewPropagator(propagator propagation.TextMapPropagator) connection.Propagator {
+	return propagatorAdapter{propagator: propagator}
+}
+
+type propagatorAdapter struct {
+	propagator propagation.TextMapPropagator
+}
+
+func (p propagatorAdapter) Inject(ctx context.Context, carrier connection.TextMapCarrier) {
+	// The carrier has the methods of propagation.TextMapCarrier.
+	p.propagator.Inject(ctx, carrier)
+}
+
+// keyValues converts the attributes to the OpenTelemetry attributes.
+func keyValues(attributes []connection.Attribute) []attribute.KeyValue {
+	kv := make([]attribute.KeyValue, 0, len(attributes))
+	for _, a := range attributes {
+		switch v := a.Value.(type) {
+		case string:
+			kv = append(kv, attribute.String(a.Key, v))
+		case int:
+			kv = append(kv, attribute.Int(a.Key, v))
+		case int64:
+			kv = append(kv, attribute.Int64(a.Key, v))
+		case float64:
+			kv = append(kv, attribute.Float64(a.Key, v))
+		case bool:
+			kv = append(kv, attribute.Bool(a.Key, v))
+		default:
+			kv = append(kv, attribute.String(a.Key, fmt.Sprint(v)))
+		}
+	}
+
+	return kv
+}
@@ -0,0 +1,85 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package otel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/arangodb/go-driver/v2/connection"
+)
+
+func TestTelemetryConfiguration(t *testing.T) {
+	var headers http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		w.Header().Set("Content-Type", connection.ApplicationJSON)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error":true,"code":503,"errorNum":503,"errorMessage":"unavailable"}`))
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	config := NewTelemetryConfiguration(Configuration{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+		Propagator:     propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}),
+	})
+
+	conn := connection.NewTelemetryWrapper(config)(connection.NewHttpConnection(connection.HttpConfiguration{
+		Endpoint:    connection.NewRoundRobinEndpoints([]string{server.URL}),
+		ContentType: connection.ApplicationJSON,
+	}))
+
+	member, err := baggage.NewMember("user", "test")
+	require.NoError(t, err)
+	bag, err := baggage.New(member)
+	require.NoError(t, err)
+
+	_, err = connection.CallWithChecks(baggage.ContextWithBaggage(context.Background(), bag), conn, http.MethodGet,
+		"_db/mydb/_api/collection/users", nil, []int{http.StatusOK})
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "GET /_api/collection", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, codes.Error, span.Status().Code)
+	assert.Contains(t, span.Attributes(), attribute.String(connection.AttributeDBName, "mydb"))
+	assert.Contains(t, span.Attributes(), attribute.String(connection.AttributeDBCollection, "users"))
+	assert.Contains(t, span.Attributes(), attribute.Int(connection.AttributeErrorNum, 503))
+
+	// The context is propagated with the configured propagator.
+	assert.Contains(t, headers.Get("traceparent"), span.SpanContext().TraceID().String())
+	assert.Equal(t, "user=test", headers.Get("baggage"))
+}
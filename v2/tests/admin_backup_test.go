@@ -0,0 +1,58 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_AdminBackup(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+			skipNoEnterprise(client, ctx, t)
+
+			backup, err := client.CreateBackup(ctx, &arangodb.BackupCreateOptions{Label: "go-driver-test"})
+			require.NoError(t, err)
+			require.NotEmpty(t, backup.ID)
+			require.Contains(t, backup.ID, "go-driver-test")
+
+			list, err := client.ListBackups(ctx, nil)
+			require.NoError(t, err)
+			require.Contains(t, list.Backups, backup.ID)
+
+			list, err = client.ListBackups(ctx, &arangodb.BackupListOptions{ID: backup.ID})
+			require.NoError(t, err)
+			require.Len(t, list.Backups, 1)
+			require.True(t, list.Backups[backup.ID].Available)
+
+			require.NoError(t, client.DeleteBackup(ctx, backup.ID))
+
+			err = client.DeleteBackup(ctx, backup.ID)
+			require.True(t, shared.IsNotFound(err), "expected not found, got %v", err)
+		})
+	})
+}
@@ -0,0 +1,143 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+)
+
+func Test_ClusterDatabaseInventory(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+					inventory, err := client.DatabaseInventory(ctx, db.Name())
+					require.NoError(t, err)
+					require.Equal(t, db.Name(), inventory.Info.Name)
+
+					c, found := inventory.CollectionByName(col.Name())
+					require.True(t, found, "collection %s not found in inventory", col.Name())
+					require.NotEmpty(t, c.Parameters.Shards)
+				})
+			})
+		})
+	})
+}
+
+func Test_ClusterNumberOfServers(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+			servers, err := client.NumberOfServers(ctx)
+			require.NoError(t, err)
+			require.Greater(t, servers.NoCoordinators, 0)
+			require.Greater(t, servers.NoDBServers, 0)
+
+			health, err := client.Health(ctx)
+			require.NoError(t, err)
+
+			for id, h := range health.Health {
+				if h.Role != arangodb.ServerRoleDBServer {
+					continue
+				}
+
+				cleanedOut, err := client.IsCleanedOut(ctx, id)
+				require.NoError(t, err)
+				require.False(t, cleanedOut)
+			}
+		})
+	})
+}
+
+func Test_ClusterRebalance(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+			skipBelowVersion(client, ctx, "3.10", t)
+
+			balance, err := client.GetClusterRebalance(ctx)
+			require.NoError(t, err)
+			require.NotEmpty(t, balance.Leader.NumberShards)
+
+			plan, err := client.ComputeClusterRebalance(ctx, &arangodb.RebalanceRequestBody{
+				MaximumNumberOfMoves: 10,
+				LeaderChanges:        newBool(true),
+				MoveLeaders:          newBool(true),
+				MoveFollowers:        newBool(true),
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(plan.Moves), 10)
+
+			require.NoError(t, client.ExecuteClusterRebalance(ctx, nil))
+		})
+	})
+}
+
+func Test_ClusterMaintenance(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+			skipBelowVersion(client, ctx, "3.8", t)
+
+			require.NoError(t, client.SetClusterMaintenanceMode(ctx, true))
+			require.NoError(t, client.SetClusterMaintenanceMode(ctx, false))
+
+			health, err := client.Health(ctx)
+			require.NoError(t, err)
+
+			for id, h := range health.Health {
+				if h.Role != arangodb.ServerRoleDBServer {
+					continue
+				}
+
+				require.NoError(t, client.SetDBServerMaintenance(ctx, id, &arangodb.DBServerMaintenanceOptions{
+					Mode:    arangodb.DBServerMaintenanceModeMaintenance,
+					Timeout: time.Minute,
+				}))
+
+				status, err := client.GetDBServerMaintenance(ctx, id)
+				require.NoError(t, err)
+				require.Equal(t, arangodb.DBServerMaintenanceModeMaintenance, status.Mode)
+
+				require.NoError(t, client.SetDBServerMaintenance(ctx, id, &arangodb.DBServerMaintenanceOptions{
+					Mode: arangodb.DBServerMaintenanceModeNormal,
+				}))
+
+				status, err = client.GetDBServerMaintenance(ctx, id)
+				require.NoError(t, err)
+				require.Empty(t, status.Mode)
+
+				break
+			}
+		})
+	})
+}
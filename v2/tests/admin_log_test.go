@@ -140,3 +140,18 @@ func changeLogLevel(l string) string {
 
 	return "INFO"
 }
+
+// Test_Logs tests retrieval of the log entries.
+func Test_Logs(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+			skipBelowVersion(client, ctx, "3.8", t)
+
+			size := 5
+			logs, err := client.Logs(ctx, &arangodb.LogEntriesOptions{Upto: "info", Size: &size, Sort: "desc"})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(logs.Messages), size)
+			require.GreaterOrEqual(t, logs.Total, len(logs.Messages))
+		})
+	})
+}
@@ -0,0 +1,81 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+)
+
+func Test_AdminMetrics(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+			skipBelowVersion(client, ctx, "3.8", t)
+
+			raw, err := client.Metrics(ctx)
+			require.NoError(t, err)
+			require.NotEmpty(t, raw)
+
+			families, err := client.MetricFamilies(ctx, "")
+			require.NoError(t, err)
+
+			family, ok := families["arangodb_scheduler_queue_length"]
+			require.True(t, ok)
+			require.Equal(t, arangodb.MetricTypeGauge, family.Type)
+			require.NotEmpty(t, family.Metrics)
+
+			_, ok = families.Value("arangodb_scheduler_queue_length", nil)
+			require.True(t, ok)
+		})
+	})
+}
+
+func Test_AdminStatistics(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+			stats, err := client.Statistics(ctx)
+			if err != nil {
+				// Statistics are disabled on some deployments.
+				t.Skipf("statistics are not available: %s", err)
+			}
+			require.NotZero(t, stats.Server.Uptime)
+			require.NotZero(t, stats.System.NumberOfThreads)
+		})
+	})
+}
+
+func Test_AdminShutdownInfo(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+			skipBelowVersion(client, ctx, "3.8", t)
+
+			info, err := client.ShutdownInfo(ctx)
+			require.NoError(t, err)
+			require.False(t, info.SoftShutdownOngoing)
+		})
+	})
+}
@@ -0,0 +1,93 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+)
+
+func Test_BulkLoader(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					newSource := func(n int) arangodb.ImportSource {
+						i := 0
+						return arangodb.NewImportSourceIterator(func() (UserDocWithKey, error) {
+							if i == n {
+								return UserDocWithKey{}, io.EOF
+							}
+							i++
+							return UserDocWithKey{Key: fmt.Sprintf("bulk%d", i), Name: "bulk", Age: i}, nil
+						})
+					}
+
+					for _, method := range []arangodb.BulkLoaderMethod{arangodb.BulkLoaderMethodImport, arangodb.BulkLoaderMethodCreate} {
+						t.Run(string(method), func(t *testing.T) {
+							require.NoError(t, col.Truncate(ctx))
+
+							var calls int
+							opts := arangodb.BulkLoaderOptions{
+								Method:    method,
+								BatchSize: 50,
+								Workers:   3,
+								OnProgress: func(progress arangodb.BulkLoaderProgress) {
+									calls++
+								},
+							}
+
+							loader, err := arangodb.NewBulkLoader(col, &opts)
+							require.NoError(t, err)
+
+							progress, err := loader.Load(ctx, newSource(500))
+							require.NoError(t, err)
+							require.Equal(t, int64(500), progress.Offset)
+							require.Equal(t, int64(500), progress.Created)
+							require.Equal(t, 10, calls)
+
+							t.Run("resume", func(t *testing.T) {
+								opts.Offset = 400
+								loader, err := arangodb.NewBulkLoader(col, &opts)
+								require.NoError(t, err)
+
+								progress, err := loader.Load(ctx, newSource(600))
+								require.NoError(t, err)
+								require.Equal(t, int64(600), progress.Offset)
+								require.Equal(t, int64(100), progress.Created)
+
+								count, err := col.Count(ctx)
+								require.NoError(t, err)
+								require.Equal(t, int64(600), count)
+							})
+						})
+					}
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,87 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+)
+
+func Test_ChangeFeed(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(checkpoints arangodb.Collection) {
+				WithCollection(t, db, nil, func(col arangodb.Collection) {
+					withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+						store := arangodb.NewChangeFeedCollectionCheckpointStore(checkpoints, "feed")
+
+						tick, err := store.Load(ctx)
+						require.NoError(t, err)
+						require.Empty(t, tick)
+
+						state, err := client.LoggerState(ctx, db.Name())
+						require.NoError(t, err)
+
+						feed := arangodb.NewChangeFeed(client, db.Name(), &arangodb.ChangeFeedOptions{
+							From:            arangodb.Tick(state.State.LastLogTick),
+							Collections:     []string{col.Name()},
+							CheckpointStore: store,
+							PollInterval:    100 * time.Millisecond,
+						})
+
+						feedCtx, cancel := context.WithCancel(ctx)
+						defer cancel()
+
+						events, errs := feed.Events(feedCtx)
+
+						meta, err := col.CreateDocument(ctx, UserDoc{Name: "feed"})
+						require.NoError(t, err)
+
+						for event := range events {
+							require.Equal(t, col.Name(), event.Collection)
+							if event.Type == arangodb.ReplicationLogEntryTypeDocumentInsertOrReplace && event.Key == meta.Key {
+								var doc UserDoc
+								require.NoError(t, event.Unmarshal(&doc))
+								require.Equal(t, "feed", doc.Name)
+								break
+							}
+						}
+						cancel()
+						<-errs
+						require.NotEmpty(t, feed.Tick())
+
+						require.NoError(t, store.Save(ctx, feed.Tick()))
+						tick, err = store.Load(ctx)
+						require.NoError(t, err)
+						require.Equal(t, feed.Tick(), tick)
+					})
+				})
+			})
+		})
+	})
+}
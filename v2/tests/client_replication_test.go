@@ -0,0 +1,173 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_ClientReplicationRevisions(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					skipBelowVersion(client, ctx, "3.8", t)
+
+					const noOfDocuments = 1000
+					err := arangodb.CreateDocuments(ctx, col, noOfDocuments, func(index int) any {
+						return UserDoc{Name: "User", Age: index}
+					})
+					require.NoError(t, err)
+
+					batch, err := client.CreateBatch(ctx, db.Name(), 123, time.Hour)
+					require.NoError(t, err)
+					defer batch.Delete(context.Background())
+
+					require.NotEmpty(t, batch.BatchID())
+					require.NoError(t, batch.Extend(ctx, time.Hour))
+
+					t.Run("Inventory", func(t *testing.T) {
+						inventory, err := client.ReplicationInventory(ctx, db.Name(), &arangodb.ReplicationInventoryOptions{
+							BatchID: batch.BatchID(),
+						})
+						require.NoError(t, err)
+
+						_, found := inventory.CollectionByName(col.Name())
+						require.True(t, found)
+					})
+
+					tree, err := client.GetRevisionTree(ctx, db.Name(), batch.BatchID(), col.Name())
+					if shared.IsArangoErrorWithCode(err, http.StatusNotImplemented) {
+						t.Skip("Collection '" + col.Name() + "' does not support revision-based replication")
+					}
+					require.NoError(t, err)
+					require.Equal(t, noOfDocuments, int(tree.Count))
+					require.NotEmpty(t, tree.Nodes)
+
+					var revisions arangodb.Revisions
+					var resume arangodb.RevisionUInt64
+					for {
+						reader, err := client.GetRevisionsByRanges(ctx, db.Name(), batch.BatchID(), col.Name(),
+							[]arangodb.RevisionMinMax{{tree.RangeMin, tree.RangeMax}}, resume)
+						require.NoError(t, err)
+
+						for {
+							r, err := reader.Read()
+							if shared.IsNoMoreDocuments(err) {
+								break
+							}
+							require.NoError(t, err)
+							revisions = append(revisions, r...)
+						}
+						require.NoError(t, reader.Close())
+
+						if reader.Resume() == 0 {
+							break
+						}
+						resume = reader.Resume()
+					}
+					require.Len(t, revisions, noOfDocuments)
+
+					reader, err := client.GetRevisionDocuments(ctx, db.Name(), batch.BatchID(), col.Name(), revisions[:10])
+					require.NoError(t, err)
+					defer reader.Close()
+
+					count := 0
+					for {
+						var doc UserDoc
+						err := reader.Read(&doc)
+						if shared.IsNoMoreDocuments(err) {
+							break
+						}
+						require.NoError(t, err)
+						require.Equal(t, "User", doc.Name)
+						count++
+					}
+					require.Equal(t, 10, count)
+
+					require.NoError(t, batch.Delete(ctx))
+					require.Equal(t, arangodb.ErrBatchClosed, errors.Cause(batch.Delete(ctx)))
+				})
+			})
+		})
+	})
+}
+
+func Test_ClientReplicationLogger(t *testing.T) {
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				state, err := client.LoggerState(ctx, db.Name())
+				require.NoError(t, err)
+				require.True(t, state.State.Running)
+				require.NotEmpty(t, state.Server.ServerID)
+
+				WithCollection(t, db, nil, func(col arangodb.Collection) {
+					_, err := col.CreateDocument(ctx, UserDoc{Name: "logger"})
+					require.NoError(t, err)
+
+					from := arangodb.Tick(state.State.LastLogTick)
+					found := false
+					for !found {
+						reader, err := client.LoggerFollow(ctx, db.Name(), &arangodb.LoggerFollowOptions{From: from})
+						require.NoError(t, err)
+
+						for {
+							entry, err := reader.Read()
+							if shared.IsNoMoreDocuments(err) {
+								break
+							}
+							require.NoError(t, err)
+
+							if entry.Type == arangodb.ReplicationLogEntryTypeDocumentInsertOrReplace {
+								found = true
+							}
+						}
+						require.NoError(t, reader.Close())
+
+						logState := reader.State()
+						require.True(t, logState.FromPresent)
+						if !logState.CheckMore && !found {
+							break
+						}
+						if logState.LastIncluded != "" {
+							from = logState.LastIncluded
+						}
+					}
+					require.True(t, found)
+				})
+			})
+		})
+	})
+}
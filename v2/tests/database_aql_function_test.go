@@ -0,0 +1,116 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_DatabaseAQLFunctions(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				add := arangodb.AQLFunctionCode{Code: "function (a, b) { return a + b; }", IsDeterministic: true}
+
+				t.Run("register", func(t *testing.T) {
+					created, err := db.RegisterAQLFunction(ctx, arangodb.AQLFunction{Name: "test::add", AQLFunctionCode: add})
+					require.NoError(t, err)
+					require.True(t, created)
+
+					created, err = db.RegisterAQLFunction(ctx, arangodb.AQLFunction{Name: "test::add", AQLFunctionCode: add})
+					require.NoError(t, err)
+					require.False(t, created)
+
+					var result int
+					cursor, err := db.Query(ctx, "RETURN test::add(1, 2)", nil)
+					require.NoError(t, err)
+					defer cursor.Close()
+
+					_, err = cursor.ReadDocument(ctx, &result)
+					require.NoError(t, err)
+					require.Equal(t, 3, result)
+				})
+
+				t.Run("list", func(t *testing.T) {
+					functions, err := db.AQLFunctions(ctx, "test")
+					require.NoError(t, err)
+					require.Len(t, functions, 1)
+					require.Equal(t, "test::add", functions[0].Name)
+					require.True(t, functions[0].IsDeterministic)
+
+					functions, err = db.AQLFunctions(ctx, "other")
+					require.NoError(t, err)
+					require.Empty(t, functions)
+				})
+
+				t.Run("sync", func(t *testing.T) {
+					_, err := db.RegisterAQLFunction(ctx, arangodb.AQLFunction{
+						Name:            "test::obsolete",
+						AQLFunctionCode: arangodb.AQLFunctionCode{Code: "function () { return 1; }"},
+					})
+					require.NoError(t, err)
+
+					functions := map[string]arangodb.AQLFunctionCode{
+						"test::add": add,
+						"test::mul": {Code: "function (a, b) { return a * b; }", IsDeterministic: true},
+					}
+
+					result, err := db.SyncAQLFunctions(ctx, "test", functions)
+					require.NoError(t, err)
+					require.Equal(t, []string{"test::mul"}, result.Created)
+					require.Equal(t, []string{"test::add"}, result.Unchanged)
+					require.Equal(t, []string{"test::obsolete"}, result.Removed)
+					require.Empty(t, result.Replaced)
+
+					functions["test::add"] = arangodb.AQLFunctionCode{Code: "function (a, b) { return b + a; }"}
+					result, err = db.SyncAQLFunctions(ctx, "test", functions)
+					require.NoError(t, err)
+					require.Equal(t, []string{"test::add"}, result.Replaced)
+					require.Equal(t, []string{"test::mul"}, result.Unchanged)
+
+					_, err = db.SyncAQLFunctions(ctx, "test", map[string]arangodb.AQLFunctionCode{"other::f": add})
+					require.True(t, shared.IsInvalidArgument(err))
+				})
+
+				t.Run("unregister", func(t *testing.T) {
+					require.NoError(t, db.UnregisterAQLFunction(ctx, "test::mul"))
+
+					err := db.UnregisterAQLFunction(ctx, "test::mul")
+					require.True(t, shared.IsNotFound(err))
+
+					count, err := db.UnregisterAQLFunctionGroup(ctx, "test")
+					require.NoError(t, err)
+					require.Equal(t, 1, count)
+
+					functions, err := db.AQLFunctions(ctx, "test")
+					require.NoError(t, err)
+					require.Empty(t, functions)
+				})
+			})
+		})
+	})
+}
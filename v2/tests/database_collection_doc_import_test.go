@@ -0,0 +1,125 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+)
+
+func Test_DatabaseCollectionDocImport(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					t.Run("channel", func(t *testing.T) {
+						docs := make(chan UserDocWithKey)
+						go func() {
+							defer close(docs)
+							for i := 0; i < 100; i++ {
+								docs <- UserDocWithKey{Key: fmt.Sprintf("ch%d", i), Name: "channel", Age: i}
+							}
+						}()
+
+						result, err := col.ImportDocuments(ctx, arangodb.NewImportSourceChannel(docs),
+							&arangodb.CollectionDocumentImportOptions{ChunkSize: 7, Concurrency: 4})
+						require.NoError(t, err)
+						require.Equal(t, int64(100), result.Created)
+						require.Equal(t, int64(0), result.Errors)
+						require.Equal(t, 15, result.Chunks)
+
+						var doc UserDocWithKey
+						_, err = col.ReadDocument(ctx, "ch42", &doc)
+						require.NoError(t, err)
+						require.Equal(t, 42, doc.Age)
+					})
+
+					t.Run("arrays with errors", func(t *testing.T) {
+						input := "[\"_key\",\"name\",\"age\"]\n" +
+							"[\"ar1\",\"arrays\",1]\n" +
+							"[\"ch1\",\"duplicate\",2]\n" +
+							"[\"ar3\",\"arrays\",3]\n" +
+							"[\"ch3\",\"duplicate\",4]\n"
+
+						result, err := col.ImportDocuments(ctx, arangodb.NewImportSourceReader(strings.NewReader(input), arangodb.ImportTypeArrays),
+							&arangodb.CollectionDocumentImportOptions{ChunkSize: 2, Details: newBool(true)})
+						require.NoError(t, err)
+						require.Equal(t, int64(2), result.Created)
+						require.Equal(t, int64(2), result.Errors)
+						require.Len(t, result.Details, 2)
+						require.Equal(t, int64(1), result.Details[0].Index)
+						require.Equal(t, int64(3), result.Details[1].Index)
+					})
+
+					t.Run("list with update on duplicate", func(t *testing.T) {
+						input := `[
+  {"_key": "ch1", "name": "updated"},
+  {"_key": "li1", "name": "list"}
+]`
+						onDuplicate := arangodb.ImportOnDuplicateUpdate
+						result, err := col.ImportDocuments(ctx, arangodb.NewImportSourceReader(strings.NewReader(input), arangodb.ImportTypeList),
+							&arangodb.CollectionDocumentImportOptions{OnDuplicate: &onDuplicate})
+						require.NoError(t, err)
+						require.Equal(t, int64(1), result.Created)
+						require.Equal(t, int64(1), result.Updated)
+
+						var doc UserDocWithKey
+						_, err = col.ReadDocument(ctx, "ch1", &doc)
+						require.NoError(t, err)
+						require.Equal(t, "updated", doc.Name)
+						require.Equal(t, 1, doc.Age)
+					})
+
+					t.Run("complete fails on error", func(t *testing.T) {
+						input := "{\"_key\":\"co1\"}\n{\"_key\":\"ch2\"}\n"
+
+						_, err := col.ImportDocuments(ctx, arangodb.NewImportSourceReader(strings.NewReader(input), arangodb.ImportTypeDocuments),
+							&arangodb.CollectionDocumentImportOptions{Complete: newBool(true)})
+						require.Error(t, err)
+
+						exists, err := col.DocumentExists(ctx, "co1")
+						require.NoError(t, err)
+						require.False(t, exists)
+					})
+
+					t.Run("overwrite", func(t *testing.T) {
+						input := "{\"_key\":\"ow1\"}\n"
+
+						result, err := col.ImportDocuments(ctx, arangodb.NewImportSourceReader(strings.NewReader(input), arangodb.ImportTypeDocuments),
+							&arangodb.CollectionDocumentImportOptions{Overwrite: newBool(true)})
+						require.NoError(t, err)
+						require.Equal(t, int64(1), result.Created)
+
+						count, err := col.Count(ctx)
+						require.NoError(t, err)
+						require.Equal(t, int64(1), count)
+					})
+				})
+			})
+		})
+	})
+}
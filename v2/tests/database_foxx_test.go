@@ -0,0 +1,215 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+const foxxTestManifest = `{
+  "name": "go-driver-test",
+  "version": "1.0.0",
+  "main": "index.js",
+  "configuration": {
+    "greeting": {"type": "string", "default": "hello", "required": false}
+  },
+  "scripts": {
+    "echo": "echo.js"
+  },
+  "tests": "test.js"
+}`
+
+const foxxTestIndex = `'use strict';
+const createRouter = require('@arangodb/foxx/router');
+const router = createRouter();
+module.context.use(router);
+router.get('/hello', function (req, res) {
+  res.send({greeting: module.context.configuration.greeting});
+});
+`
+
+const foxxTestScript = `'use strict';
+module.exports = {args: module.context.argv[0]};
+`
+
+const foxxTestTests = `'use strict';
+const expect = require('chai').expect;
+describe('greeting', function () {
+  it('is configured', function () {
+    expect(module.context.configuration.greeting).to.be.a('string');
+  });
+});
+`
+
+// newFoxxTestBundle creates the zip archive of a small Foxx service.
+func newFoxxTestBundle(t testing.TB) []byte {
+	var buf bytes.Buffer
+
+	w := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"manifest.json": foxxTestManifest,
+		"index.js":      foxxTestIndex,
+		"echo.js":       foxxTestScript,
+		"test.js":       foxxTestTests,
+		"README.md":     "# go-driver-test",
+	} {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func Test_DatabaseFoxxService(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				bundle := newFoxxTestBundle(t)
+				mount := fmt.Sprintf("/test-%s", uuid.New().String())
+
+				service, err := db.InstallFoxxService(ctx, mount, arangodb.FoxxServiceSource{Bundle: bytes.NewReader(bundle)}, nil)
+				require.NoError(t, err)
+				require.Equal(t, mount, service.Mount)
+				require.Equal(t, "go-driver-test", service.Name)
+
+				t.Run("List services", func(t *testing.T) {
+					services, err := db.FoxxServices(ctx, &arangodb.FoxxListOptions{ExcludeSystem: newBool(true)})
+					require.NoError(t, err)
+
+					found := false
+					for _, s := range services {
+						if s.Mount == mount {
+							found = true
+							require.Equal(t, "1.0.0", s.Version)
+						}
+					}
+					require.True(t, found)
+				})
+
+				t.Run("Get service", func(t *testing.T) {
+					s, err := db.FoxxService(ctx, mount)
+					require.NoError(t, err)
+					require.Equal(t, mount, s.Mount)
+					require.False(t, s.Development)
+				})
+
+				t.Run("Configuration", func(t *testing.T) {
+					cfg, err := db.FoxxServiceConfiguration(ctx, mount)
+					require.NoError(t, err)
+					require.Contains(t, cfg, "greeting")
+					require.Equal(t, "string", cfg["greeting"].Type)
+
+					cfg, err = db.UpdateFoxxServiceConfiguration(ctx, mount, map[string]interface{}{"greeting": "hi"})
+					require.NoError(t, err)
+					require.Equal(t, "hi", cfg["greeting"].Current)
+
+					cfg, err = db.ReplaceFoxxServiceConfiguration(ctx, mount, map[string]interface{}{})
+					require.NoError(t, err)
+					require.Equal(t, "hello", cfg["greeting"].Current)
+				})
+
+				t.Run("Dependencies", func(t *testing.T) {
+					deps, err := db.FoxxServiceDependencies(ctx, mount)
+					require.NoError(t, err)
+					require.Empty(t, deps)
+				})
+
+				t.Run("Development mode", func(t *testing.T) {
+					s, err := db.EnableFoxxServiceDevelopmentMode(ctx, mount)
+					require.NoError(t, err)
+					require.True(t, s.Development)
+
+					s, err = db.DisableFoxxServiceDevelopmentMode(ctx, mount)
+					require.NoError(t, err)
+					require.False(t, s.Development)
+				})
+
+				t.Run("Scripts", func(t *testing.T) {
+					scripts, err := db.FoxxServiceScripts(ctx, mount)
+					require.NoError(t, err)
+					require.Contains(t, scripts, "echo")
+
+					var result struct {
+						Args string `json:"args"`
+					}
+					err = db.RunFoxxServiceScript(ctx, mount, "echo", "test-arg", &result)
+					require.NoError(t, err)
+					require.Equal(t, "test-arg", result.Args)
+				})
+
+				t.Run("Tests", func(t *testing.T) {
+					var report arangodb.FoxxTestReport
+					err := db.RunFoxxServiceTests(ctx, mount, nil, &report)
+					require.NoError(t, err)
+					require.Equal(t, 1, report.Stats.Tests)
+					require.Equal(t, 1, report.Stats.Passes)
+				})
+
+				t.Run("Readme and swagger", func(t *testing.T) {
+					readme, err := db.FoxxServiceReadme(ctx, mount)
+					require.NoError(t, err)
+					require.True(t, strings.HasPrefix(string(readme), "# go-driver-test"))
+
+					var swagger map[string]interface{}
+					err = db.FoxxServiceSwagger(ctx, mount, &swagger)
+					require.NoError(t, err)
+					require.Contains(t, swagger, "paths")
+				})
+
+				t.Run("Upgrade and replace", func(t *testing.T) {
+					s, err := db.UpgradeFoxxService(ctx, mount, arangodb.FoxxServiceSource{Bundle: bytes.NewReader(bundle)}, nil)
+					require.NoError(t, err)
+					require.Equal(t, mount, s.Mount)
+
+					s, err = db.ReplaceFoxxService(ctx, mount, arangodb.FoxxServiceSource{Bundle: bytes.NewReader(bundle)},
+						&arangodb.FoxxReplaceOptions{Teardown: newBool(false)})
+					require.NoError(t, err)
+					require.Equal(t, mount, s.Mount)
+				})
+
+				t.Run("Invalid source", func(t *testing.T) {
+					_, err := db.InstallFoxxService(ctx, mount+"-invalid", arangodb.FoxxServiceSource{}, nil)
+					require.True(t, shared.IsInvalidArgument(err))
+				})
+
+				err = db.UninstallFoxxService(ctx, mount, &arangodb.FoxxUninstallOptions{Teardown: newBool(true)})
+				require.NoError(t, err)
+
+				_, err = db.FoxxService(ctx, mount)
+				require.Error(t, err)
+			})
+		})
+	})
+}
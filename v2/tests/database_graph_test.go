@@ -0,0 +1,212 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_GraphCreation(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+				name := fmt.Sprintf("test-graph-%s", uuid.New().String())
+				edgeCol := fmt.Sprintf("test-edge-%s", uuid.New().String())
+				fromCol := fmt.Sprintf("test-from-%s", uuid.New().String())
+				toCol := fmt.Sprintf("test-to-%s", uuid.New().String())
+				orphanCol := fmt.Sprintf("test-orphan-%s", uuid.New().String())
+
+				exists, err := db.GraphExists(ctx, name)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				g, err := db.CreateGraph(ctx, name, &arangodb.CreateGraphOptions{
+					EdgeDefinitions: []arangodb.EdgeDefinition{
+						{Collection: edgeCol, From: []string{fromCol}, To: []string{toCol}},
+					},
+					OrphanCollections: []string{orphanCol},
+				})
+				require.NoError(t, err)
+				require.Equal(t, name, g.Name())
+				require.Len(t, g.EdgeDefinitions(), 1)
+				require.Equal(t, []string{orphanCol}, g.OrphanCollections())
+
+				exists, err = db.GraphExists(ctx, name)
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				_, err = db.CreateGraph(ctx, name, nil)
+				require.True(t, shared.IsConflict(err), "expected conflict, got %v", err)
+
+				g, err = db.Graph(ctx, name)
+				require.NoError(t, err)
+				require.Equal(t, edgeCol, g.EdgeDefinitions()[0].Collection)
+
+				found := false
+				reader, err := db.Graphs(ctx)
+				require.NoError(t, err)
+				for {
+					gr, err := reader.Read()
+					if shared.IsNoMoreDocuments(err) {
+						break
+					}
+					require.NoError(t, err)
+					if gr.Name() == name {
+						found = true
+					}
+				}
+				require.True(t, found, "graph %s not listed", name)
+
+				require.NoError(t, g.Remove(ctx, &arangodb.RemoveGraphOptions{DropCollections: newBool(true)}))
+
+				_, err = db.Graph(ctx, name)
+				require.True(t, shared.IsNotFound(err), "expected not found, got %v", err)
+			})
+		})
+	})
+}
+
+func Test_GraphVertexCollections(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+				g, err := db.CreateGraph(ctx, fmt.Sprintf("test-graph-%s", uuid.New().String()), nil)
+				require.NoError(t, err)
+
+				name := fmt.Sprintf("test-vertex-%s", uuid.New().String())
+
+				exists, err := g.VertexCollectionExists(ctx, name)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				vc, err := g.CreateVertexCollection(ctx, name, nil)
+				require.NoError(t, err)
+				require.Equal(t, name, vc.Name())
+				require.Equal(t, g.Name(), vc.Graph().Name())
+
+				vc, err = g.VertexCollection(ctx, name)
+				require.NoError(t, err)
+				require.Equal(t, name, vc.Name())
+
+				list, err := g.VertexCollections(ctx)
+				require.NoError(t, err)
+				require.Len(t, list, 1)
+
+				require.NoError(t, g.DeleteVertexCollection(ctx, name, &arangodb.DeleteVertexCollectionOptions{DropCollection: newBool(true)}))
+
+				_, err = g.VertexCollection(ctx, name)
+				require.True(t, shared.IsNotFound(err), "expected not found, got %v", err)
+
+				colExists, err := db.CollectionExists(ctx, name)
+				require.NoError(t, err)
+				require.False(t, colExists)
+
+				require.NoError(t, g.Remove(ctx, nil))
+			})
+		})
+	})
+}
+
+func Test_GraphEdgeDefinitions(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+				g, err := db.CreateGraph(ctx, fmt.Sprintf("test-graph-%s", uuid.New().String()), nil)
+				require.NoError(t, err)
+
+				edgeCol := fmt.Sprintf("test-edge-%s", uuid.New().String())
+				fromCol := fmt.Sprintf("test-from-%s", uuid.New().String())
+				toCol := fmt.Sprintf("test-to-%s", uuid.New().String())
+
+				ec, err := g.CreateEdgeDefinition(ctx, edgeCol, []string{fromCol}, []string{toCol}, nil)
+				require.NoError(t, err)
+				require.Equal(t, edgeCol, ec.Name())
+
+				exists, err := g.EdgeCollectionExists(ctx, edgeCol)
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				vertices, err := g.VertexCollections(ctx)
+				require.NoError(t, err)
+				require.Len(t, vertices, 2)
+
+				require.NoError(t, g.ReplaceEdgeDefinition(ctx, edgeCol, []string{fromCol}, []string{fromCol}, nil))
+
+				g, err = db.Graph(ctx, g.Name())
+				require.NoError(t, err)
+				require.Len(t, g.EdgeDefinitions(), 1)
+				require.Equal(t, []string{fromCol}, g.EdgeDefinitions()[0].To)
+
+				list, err := g.EdgeCollections(ctx)
+				require.NoError(t, err)
+				require.Len(t, list, 1)
+
+				require.NoError(t, g.DeleteEdgeDefinition(ctx, edgeCol, &arangodb.DeleteEdgeDefinitionOptions{DropCollections: newBool(true)}))
+
+				exists, err = g.EdgeCollectionExists(ctx, edgeCol)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				require.NoError(t, g.Remove(ctx, &arangodb.RemoveGraphOptions{DropCollections: newBool(true)}))
+			})
+		})
+	})
+}
+
+func Test_GraphCreationSmart(t *testing.T) {
+	requireClusterMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+				skipNoEnterprise(client, ctx, t)
+
+				g, err := db.CreateGraph(ctx, fmt.Sprintf("test-graph-%s", uuid.New().String()), &arangodb.CreateGraphOptions{
+					EdgeDefinitions: []arangodb.EdgeDefinition{
+						{
+							Collection: fmt.Sprintf("test-edge-%s", uuid.New().String()),
+							From:       []string{fmt.Sprintf("test-from-%s", uuid.New().String())},
+							To:         []string{fmt.Sprintf("test-to-%s", uuid.New().String())},
+						},
+					},
+					IsSmart: true,
+					Options: &arangodb.CreateGraphShardingOptions{
+						SmartGraphAttribute: "region",
+						NumberOfShards:      2,
+					},
+				})
+				require.NoError(t, err)
+				require.True(t, g.IsSmart())
+				require.Equal(t, "region", g.Definition().SmartGraphAttribute)
+
+				require.NoError(t, g.Remove(ctx, &arangodb.RemoveGraphOptions{DropCollections: newBool(true)}))
+			})
+		})
+	})
+}
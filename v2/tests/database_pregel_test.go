@@ -0,0 +1,116 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+)
+
+func Test_DatabasePregelJob(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+				edgeCol := fmt.Sprintf("test-edge-%s", uuid.New().String())
+				vertexCol := fmt.Sprintf("test-vertex-%s", uuid.New().String())
+
+				g, err := db.CreateGraph(ctx, fmt.Sprintf("test-graph-%s", uuid.New().String()), &arangodb.CreateGraphOptions{
+					EdgeDefinitions: []arangodb.EdgeDefinition{
+						{Collection: edgeCol, From: []string{vertexCol}, To: []string{vertexCol}},
+					},
+				})
+				require.NoError(t, err)
+
+				vc, err := g.VertexCollection(ctx, vertexCol)
+				require.NoError(t, err)
+
+				ec, err := g.EdgeCollection(ctx, edgeCol)
+				require.NoError(t, err)
+
+				var ids []string
+				for i := 0; i < 5; i++ {
+					meta, err := vc.CreateVertex(ctx, map[string]interface{}{"_key": fmt.Sprintf("v%d", i)})
+					require.NoError(t, err)
+					ids = append(ids, string(meta.ID))
+				}
+
+				for i := range ids {
+					_, err := ec.CreateEdge(ctx, map[string]interface{}{"_from": ids[i], "_to": ids[(i+1)%len(ids)]})
+					require.NoError(t, err)
+				}
+
+				id, err := db.StartJob(ctx, arangodb.PregelJobOptions{
+					GraphName: g.Name(),
+					Params: arangodb.PregelPageRankParams{
+						PregelCommonParams: arangodb.PregelCommonParams{ResultField: "rank"},
+						Threshold:          0.0001,
+					},
+				})
+				require.NoError(t, err)
+				require.NotEmpty(t, id)
+
+				progressReported := false
+				job, err := db.WaitForJob(ctx, id, &arangodb.PregelWaitOptions{
+					OnProgress: func(job arangodb.PregelJob) {
+						progressReported = true
+					},
+				})
+				require.NoError(t, err)
+				require.True(t, progressReported)
+				require.Equal(t, arangodb.PregelJobStateDone, job.State)
+				require.Equal(t, arangodb.PregelAlgorithmPageRank, job.Algorithm)
+				require.EqualValues(t, len(ids), job.VertexCount)
+				require.Greater(t, job.Gss, uint64(0))
+
+				jobs, err := db.GetJobs(ctx)
+				require.NoError(t, err)
+
+				found := false
+				for _, j := range jobs {
+					if j.ID == id {
+						found = true
+					}
+				}
+				require.True(t, found, "job %s not listed", id)
+
+				var result struct {
+					Rank float64 `json:"rank"`
+				}
+				_, err = vc.ReadVertex(ctx, "v0", &result)
+				require.NoError(t, err)
+				require.Greater(t, result.Rank, 0.0)
+
+				_, err = db.StartJob(ctx, arangodb.PregelJobOptions{
+					Algorithm: arangodb.PregelAlgorithmLineRank,
+					GraphName: g.Name(),
+					Params:    arangodb.PregelPageRankParams{},
+				})
+				require.Error(t, err)
+			})
+		})
+	})
+}
@@ -0,0 +1,101 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/aql"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_QueryBuilder(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					docs := []UserDoc{{Name: "A", Age: 10}, {Name: "B", Age: 20}, {Name: "C", Age: 30}, {Name: "D", Age: 40}}
+					_, err := col.CreateDocuments(ctx, docs)
+					require.NoError(t, err)
+
+					u := aql.Var("u")
+
+					t.Run("filter, sort and limit", func(t *testing.T) {
+						q := aql.New().
+							For("u", aql.Collection(col.Name())).
+							Filter(aql.Compare(u.Attr("age"), ">", aql.Param("age", 15))).
+							Sort(aql.Desc(u.Attr("age"))).
+							Limit(2).
+							Return(u.Attr("name"))
+
+						cursor, err := q.Execute(ctx, db, nil)
+						require.NoError(t, err)
+						defer cursor.Close()
+
+						var names []string
+						for cursor.HasMore() {
+							var name string
+							_, err := cursor.ReadDocument(ctx, &name)
+							require.NoError(t, err)
+							names = append(names, name)
+						}
+						require.Equal(t, []string{"D", "C"}, names)
+					})
+
+					t.Run("collect with subquery", func(t *testing.T) {
+						q := aql.New().
+							Let("adults", aql.Subquery(aql.New().
+								For("u", aql.Collection(col.Name())).
+								Filter(aql.Compare(u.Attr("age"), ">=", aql.Value(18))).
+								Return(u))).
+							For("a", aql.Var("adults")).
+							CollectWithCount("count").
+							Return(aql.Var("count"))
+
+						cursor, err := q.Execute(ctx, db, nil)
+						require.NoError(t, err)
+						defer cursor.Close()
+
+						var count int
+						_, err = cursor.ReadDocument(ctx, &count)
+						require.NoError(t, err)
+						require.Equal(t, 3, count)
+					})
+
+					t.Run("validation", func(t *testing.T) {
+						q := aql.New().
+							For("u", aql.Collection(col.Name())).
+							Filter(aql.Expr("u.age > @age")).
+							Return(u)
+
+						_, err := q.Execute(ctx, db, nil)
+						require.Error(t, err)
+						require.True(t, shared.IsInvalidArgument(err))
+					})
+				})
+			})
+		})
+	})
+}
@@ -24,10 +24,12 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 )
 
 // Test_ExplainQuery tries to explain several AQL queries.
@@ -228,3 +230,255 @@ func Test_QueryBatchWithRetries(t *testing.T) {
 		})
 	})
 }
+
+// Test_QueryTracking lists and kills a running query and checks the slow query log.
+func Test_QueryTracking(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				original, err := db.QueryProperties(ctx)
+				require.NoError(t, err)
+				defer func() {
+					// The properties are global, so they are restored for other tests.
+					_, err := db.SetQueryProperties(ctx, arangodb.SetQueryPropertiesOptions{
+						Enabled:            &original.Enabled,
+						TrackSlowQueries:   &original.TrackSlowQueries,
+						TrackBindVars:      &original.TrackBindVars,
+						SlowQueryThreshold: &original.SlowQueryThreshold,
+					})
+					require.NoError(t, err)
+				}()
+
+				threshold := 0.5
+				properties, err := db.SetQueryProperties(ctx, arangodb.SetQueryPropertiesOptions{
+					Enabled:            newBool(true),
+					TrackSlowQueries:   newBool(true),
+					TrackBindVars:      newBool(true),
+					SlowQueryThreshold: &threshold,
+				})
+				require.NoError(t, err)
+				require.True(t, properties.Enabled)
+				require.Equal(t, threshold, properties.SlowQueryThreshold)
+
+				properties, err = db.QueryProperties(ctx)
+				require.NoError(t, err)
+				require.True(t, properties.TrackBindVars)
+
+				t.Run("kill running query", func(t *testing.T) {
+					done := make(chan error, 1)
+					go func() {
+						_, err := db.Query(ctx, "RETURN SLEEP(@seconds)", &arangodb.QueryOptions{
+							BindVars: map[string]interface{}{"seconds": 30},
+						})
+						done <- err
+					}()
+
+					var running arangodb.RunningAQLQuery
+					require.Eventually(t, func() bool {
+						queries, err := db.RunningQueries(ctx, nil)
+						if err != nil {
+							return false
+						}
+
+						for _, q := range queries {
+							if q.Query == "RETURN SLEEP(@seconds)" {
+								running = q
+								return true
+							}
+						}
+						return false
+					}, 10*time.Second, 100*time.Millisecond)
+
+					require.Equal(t, db.Name(), running.Database)
+					require.Equal(t, float64(30), running.BindVars["seconds"])
+					require.NotEmpty(t, running.ID)
+
+					require.NoError(t, db.KillQuery(ctx, running.ID, nil))
+					require.Error(t, <-done)
+				})
+
+				t.Run("slow queries", func(t *testing.T) {
+					_, err := db.Query(ctx, "RETURN SLEEP(1)", nil)
+					require.NoError(t, err)
+
+					queries, err := db.SlowQueries(ctx, nil)
+					require.NoError(t, err)
+					require.NotEmpty(t, queries)
+
+					require.NoError(t, db.ClearSlowQueries(ctx, nil))
+
+					queries, err = db.SlowQueries(ctx, nil)
+					require.NoError(t, err)
+					require.Empty(t, queries)
+				})
+
+				t.Run("kill unknown query", func(t *testing.T) {
+					err := db.KillQuery(ctx, "123456789", nil)
+					require.True(t, shared.IsNotFound(err))
+				})
+			})
+		})
+	})
+}
+
+// Test_QueryCache checks that query results are served from the cache and that the cache can be cleared.
+func Test_QueryCache(t *testing.T) {
+	// The query results cache is not available in a cluster.
+	requireSingleMode(t)
+
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					original, err := db.QueryCacheProperties(ctx)
+					require.NoError(t, err)
+					defer func() {
+						// The properties are global, so they are restored for other tests.
+						_, err := db.SetQueryCacheProperties(ctx, arangodb.SetQueryCachePropertiesOptions{Mode: &original.Mode})
+						require.NoError(t, err)
+					}()
+
+					mode := arangodb.QueryCacheModeDemand
+					properties, err := db.SetQueryCacheProperties(ctx, arangodb.SetQueryCachePropertiesOptions{Mode: &mode})
+					require.NoError(t, err)
+					require.Equal(t, arangodb.QueryCacheModeDemand, properties.Mode)
+
+					_, err = col.CreateDocument(ctx, UserDoc{Name: "cached", Age: 1})
+					require.NoError(t, err)
+
+					query := fmt.Sprintf("FOR d IN `%s` RETURN d", col.Name())
+					for i := 0; i < 3; i++ {
+						cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{Cache: true})
+						require.NoError(t, err)
+						require.NoError(t, cursor.Close())
+					}
+
+					entries, err := db.QueryCacheEntries(ctx)
+					require.NoError(t, err)
+					require.Len(t, entries, 1)
+					require.Equal(t, query, entries[0].Query)
+					require.Equal(t, uint64(1), entries[0].Results)
+					require.Equal(t, uint64(2), entries[0].Hits)
+					require.Equal(t, []string{col.Name()}, entries[0].DataSources)
+
+					require.NoError(t, db.ClearQueryCache(ctx))
+
+					entries, err = db.QueryCacheEntries(ctx)
+					require.NoError(t, err)
+					require.Empty(t, entries)
+				})
+			})
+		})
+	})
+}
+
+func Test_QueryProfile(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					docs := []UserDoc{{Name: "A", Age: 10}, {Name: "B", Age: 20}, {Name: "C", Age: 30}}
+					_, err := col.CreateDocuments(ctx, docs)
+					require.NoError(t, err)
+
+					query := fmt.Sprintf("FOR d IN `%s` FILTER d.age > 15 RETURN d", col.Name())
+					cursor, err := db.Query(ctx, query, &arangodb.QueryOptions{
+						Options: arangodb.QuerySubOptions{Profile: 2},
+					})
+					require.NoError(t, err)
+					defer cursor.Close()
+
+					require.NotEmpty(t, cursor.Profile())
+
+					profile, err := arangodb.NewQueryProfile(cursor.Plan(), cursor.Statistics(), cursor.Profile())
+					require.NoError(t, err)
+					require.NotEmpty(t, profile.Nodes)
+
+					root := profile.Plan.Root()
+					require.NotNil(t, root)
+					require.IsType(t, &arangodb.ReturnNode{}, root)
+
+					scans := profile.FullScans()
+					require.Len(t, scans, 1)
+					scan, ok := scans[0].ExecutionNode.(*arangodb.EnumerateCollectionNode)
+					require.True(t, ok)
+					require.Equal(t, col.Name(), scan.Collection)
+					require.NotZero(t, scans[0].Stats.Calls)
+
+					// The filter may be moved into the enumeration by the optimizer, so the result is checked at the root.
+					returned, ok := profile.Node(root.Base().ID)
+					require.True(t, ok)
+					require.Equal(t, uint64(2), returned.Stats.Items)
+				})
+			})
+		})
+	})
+}
+
+func Test_QueryGenericHelpers(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+				query := "FOR i IN 1..10 RETURN {name: TO_STRING(i), age: i}"
+				opts := &arangodb.QueryOptions{BatchSize: 3}
+
+				t.Run("QueryAll", func(t *testing.T) {
+					docs, err := arangodb.QueryAll[UserDoc](ctx, db, query, opts)
+					require.NoError(t, err)
+					require.Len(t, docs, 10)
+					require.Equal(t, UserDoc{Name: "10", Age: 10}, docs[9])
+				})
+
+				t.Run("QueryOne", func(t *testing.T) {
+					doc, err := arangodb.QueryOne[UserDoc](ctx, db, query, opts)
+					require.NoError(t, err)
+					require.Equal(t, UserDoc{Name: "1", Age: 1}, doc)
+
+					_, err = arangodb.QueryOne[UserDoc](ctx, db, "FOR i IN [] RETURN i", nil)
+					require.True(t, shared.IsNoMoreDocuments(err))
+				})
+
+				t.Run("Iter with early exit", func(t *testing.T) {
+					cursor, err := db.Query(ctx, query, opts)
+					require.NoError(t, err)
+
+					var ages []int
+					arangodb.Iter[UserDoc](ctx, cursor)(func(doc UserDoc, err error) bool {
+						require.NoError(t, err)
+						ages = append(ages, doc.Age)
+						return len(ages) < 5
+					})
+					require.Equal(t, []int{1, 2, 3, 4, 5}, ages)
+					require.False(t, cursor.HasMore())
+				})
+
+				t.Run("IterBatch", func(t *testing.T) {
+					var first []int
+					cursor, err := db.QueryBatch(ctx, "FOR i IN 1..10 RETURN i", opts, &first)
+					require.NoError(t, err)
+
+					sum := 0
+					arangodb.IterBatch(ctx, cursor, first)(func(i int, err error) bool {
+						require.NoError(t, err)
+						sum += i
+						return true
+					})
+					require.Equal(t, 55, sum)
+				})
+
+				t.Run("Stream", func(t *testing.T) {
+					cursor, err := db.Query(ctx, query, opts)
+					require.NoError(t, err)
+
+					docs, errs := arangodb.Stream[UserDoc](ctx, cursor, 2)
+					count := 0
+					for range docs {
+						count++
+					}
+					require.NoError(t, <-errs)
+					require.Equal(t, 10, count)
+				})
+			})
+		})
+	})
+}
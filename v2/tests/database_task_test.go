@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_DatabaseTasks(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, _ testing.TB) {
+					command := "(function (params) { require('@arangodb').db._collection(params.collection).save({}); })(params)"
+					params := map[string]string{"collection": col.Name()}
+
+					t.Run("timed task", func(t *testing.T) {
+						task, err := db.CreateTask(ctx, arangodb.TaskOptions{
+							Name:    "insert once",
+							Command: command,
+							Params:  params,
+						})
+						require.NoError(t, err)
+						require.NotEmpty(t, task.ID)
+						require.Equal(t, arangodb.TaskTypeTimed, task.Type)
+						require.Equal(t, db.Name(), task.Database)
+						require.WithinDuration(t, time.Now(), task.CreatedAt(), time.Minute)
+
+						require.Eventually(t, func() bool {
+							count, err := col.Count(ctx)
+							return err == nil && count == 1
+						}, 10*time.Second, 100*time.Millisecond)
+					})
+
+					t.Run("periodic task", func(t *testing.T) {
+						id := uuid.New().String()
+						task, err := db.CreateTask(ctx, arangodb.TaskOptions{
+							ID:      id,
+							Name:    "insert periodically",
+							Command: command,
+							Params:  params,
+							Period:  1,
+							Offset:  60,
+						})
+						require.NoError(t, err)
+						require.Equal(t, id, task.ID)
+						require.Equal(t, arangodb.TaskTypePeriodic, task.Type)
+						require.Equal(t, float64(1), task.Period)
+
+						_, err = db.CreateTask(ctx, arangodb.TaskOptions{ID: id, Name: "duplicate", Command: command})
+						require.Error(t, err)
+
+						task, err = db.Task(ctx, id)
+						require.NoError(t, err)
+						require.Equal(t, "insert periodically", task.Name)
+
+						tasks, err := db.Tasks(ctx)
+						require.NoError(t, err)
+						found := false
+						for _, task := range tasks {
+							found = found || task.ID == id
+						}
+						require.True(t, found)
+
+						require.NoError(t, db.RemoveTask(ctx, id))
+
+						_, err = db.Task(ctx, id)
+						require.True(t, shared.IsNotFound(err))
+
+						err = db.RemoveTask(ctx, id)
+						require.True(t, shared.IsNotFound(err))
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,150 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+type graphEdgeDoc struct {
+	Key  string `json:"_key,omitempty"`
+	From string `json:"_from,omitempty"`
+	To   string `json:"_to,omitempty"`
+	Kind string `json:"kind,omitempty"`
+}
+
+func Test_GraphDocuments(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+				edgeCol := fmt.Sprintf("test-edge-%s", uuid.New().String())
+				vertexCol := fmt.Sprintf("test-vertex-%s", uuid.New().String())
+
+				g, err := db.CreateGraph(ctx, fmt.Sprintf("test-graph-%s", uuid.New().String()), &arangodb.CreateGraphOptions{
+					EdgeDefinitions: []arangodb.EdgeDefinition{
+						{Collection: edgeCol, From: []string{vertexCol}, To: []string{vertexCol}},
+					},
+				})
+				require.NoError(t, err)
+
+				vc, err := g.VertexCollection(ctx, vertexCol)
+				require.NoError(t, err)
+
+				ec, err := g.EdgeCollection(ctx, edgeCol)
+				require.NoError(t, err)
+
+				// Vertices
+				var newVertex UserDocWithKey
+				alice, err := vc.CreateVertexWithOptions(ctx, UserDocWithKey{Key: "alice", Name: "Alice", Age: 30}, &arangodb.VertexCreateOptions{
+					NewObject: &newVertex,
+				})
+				require.NoError(t, err)
+				require.Equal(t, "alice", alice.Key)
+				require.NotEmpty(t, alice.Rev)
+				require.Equal(t, "Alice", newVertex.Name)
+
+				bob, err := vc.CreateVertex(ctx, UserDocWithKey{Key: "bob", Name: "Bob", Age: 40})
+				require.NoError(t, err)
+
+				var readVertex UserDocWithKey
+				meta, err := vc.ReadVertex(ctx, "alice", &readVertex)
+				require.NoError(t, err)
+				require.Equal(t, alice.DocumentMeta, meta)
+				require.Equal(t, 30, readVertex.Age)
+
+				var oldVertex, updatedVertex UserDocWithKey
+				updated, err := vc.UpdateVertexWithOptions(ctx, "alice", map[string]interface{}{"age": 31}, &arangodb.VertexUpdateOptions{
+					IfMatch:   alice.Rev,
+					OldObject: &oldVertex,
+					NewObject: &updatedVertex,
+				})
+				require.NoError(t, err)
+				require.NotEqual(t, alice.Rev, updated.Rev)
+				require.Equal(t, 30, oldVertex.Age)
+				require.Equal(t, 31, updatedVertex.Age)
+
+				_, err = vc.UpdateVertexWithOptions(ctx, "alice", map[string]interface{}{"age": 32}, &arangodb.VertexUpdateOptions{
+					IfMatch: alice.Rev,
+				})
+				require.True(t, shared.IsPreconditionFailed(err), "expected precondition failed, got %v", err)
+
+				replaced, err := vc.ReplaceVertex(ctx, "bob", UserDoc{Name: "Robert", Age: 41})
+				require.NoError(t, err)
+				require.NotEqual(t, bob.Rev, replaced.Rev)
+
+				// Edges
+				_, err = ec.CreateEdge(ctx, graphEdgeDoc{From: string(alice.ID), To: "unknown/key"})
+				require.Error(t, err)
+
+				var newEdge graphEdgeDoc
+				knows, err := ec.CreateEdgeWithOptions(ctx, graphEdgeDoc{From: string(alice.ID), To: string(bob.ID), Kind: "knows"}, &arangodb.EdgeCreateOptions{
+					NewObject: &newEdge,
+				})
+				require.NoError(t, err)
+				require.Equal(t, string(bob.ID), newEdge.To)
+
+				var readEdge graphEdgeDoc
+				_, err = ec.ReadEdge(ctx, knows.Key, &readEdge)
+				require.NoError(t, err)
+				require.Equal(t, "knows", readEdge.Kind)
+
+				_, err = ec.UpdateEdge(ctx, knows.Key, map[string]interface{}{"kind": "likes"})
+				require.NoError(t, err)
+
+				_, err = ec.ReplaceEdge(ctx, knows.Key, graphEdgeDoc{From: string(bob.ID), To: string(alice.ID), Kind: "follows"})
+				require.NoError(t, err)
+
+				var oldEdge graphEdgeDoc
+				_, err = ec.DeleteEdgeWithOptions(ctx, knows.Key, &arangodb.EdgeDeleteOptions{OldObject: &oldEdge})
+				require.NoError(t, err)
+				require.Equal(t, "follows", oldEdge.Kind)
+
+				_, err = ec.ReadEdge(ctx, knows.Key, &readEdge)
+				require.True(t, shared.IsNotFound(err), "expected not found, got %v", err)
+
+				// Removing a vertex removes its dangling edges
+				dangling, err := ec.CreateEdge(ctx, graphEdgeDoc{From: string(alice.ID), To: string(bob.ID)})
+				require.NoError(t, err)
+
+				var oldAlice UserDocWithKey
+				_, err = vc.DeleteVertexWithOptions(ctx, "alice", &arangodb.VertexDeleteOptions{OldObject: &oldAlice})
+				require.NoError(t, err)
+				require.Equal(t, "Alice", oldAlice.Name)
+
+				_, err = ec.ReadEdge(ctx, dangling.Key, nil)
+				require.True(t, shared.IsNotFound(err), "expected not found, got %v", err)
+
+				_, err = vc.DeleteVertex(ctx, "alice")
+				require.True(t, shared.IsNotFound(err), "expected not found, got %v", err)
+
+				require.NoError(t, g.Remove(ctx, &arangodb.RemoveGraphOptions{DropCollections: newBool(true)}))
+			})
+		})
+	})
+}
@@ -0,0 +1,153 @@
+//
+// DISCLAIMER
+//
+// Copyright 2023 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+)
+
+func Test_UserCreation(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+			name := fmt.Sprintf("test-user-%s", uuid.New().String())
+
+			exists, err := client.UserExists(ctx, name)
+			require.NoError(t, err)
+			require.False(t, exists)
+
+			_, err = client.User(ctx, name)
+			require.True(t, shared.IsNotFound(err), "expected not found, got %v", err)
+
+			type extra struct {
+				Team string `json:"team"`
+			}
+
+			u, err := client.CreateUser(ctx, name, &arangodb.UserOptions{
+				Password: "secret",
+				Active:   newBool(true),
+				Extra:    extra{Team: "ops"},
+			})
+			require.NoError(t, err)
+			require.Equal(t, name, u.Name())
+			require.True(t, u.IsActive())
+
+			_, err = client.CreateUser(ctx, name, nil)
+			require.True(t, shared.IsConflict(err), "expected conflict, got %v", err)
+
+			u, err = client.User(ctx, name)
+			require.NoError(t, err)
+
+			var e extra
+			require.NoError(t, u.Extra(&e))
+			require.Equal(t, "ops", e.Team)
+
+			users, err := client.Users(ctx)
+			require.NoError(t, err)
+
+			found := false
+			for _, user := range users {
+				if user.Name() == name {
+					found = true
+				}
+			}
+			require.True(t, found, "user %s not listed", name)
+
+			u, err = client.UpdateUser(ctx, name, &arangodb.UserOptions{Active: newBool(false)})
+			require.NoError(t, err)
+			require.False(t, u.IsActive())
+
+			require.NoError(t, u.Extra(&e))
+			require.Equal(t, "ops", e.Team)
+
+			u, err = client.ReplaceUser(ctx, name, &arangodb.UserOptions{Active: newBool(true)})
+			require.NoError(t, err)
+			require.True(t, u.IsActive())
+
+			require.NoError(t, client.RemoveUser(ctx, name))
+
+			err = client.RemoveUser(ctx, name)
+			require.True(t, shared.IsNotFound(err), "expected not found, got %v", err)
+		})
+	})
+}
+
+func Test_UserPermissions(t *testing.T) {
+	Wrap(t, func(t *testing.T, client arangodb.Client) {
+		WithDatabase(t, client, nil, func(db arangodb.Database) {
+			WithCollection(t, db, nil, func(col arangodb.Collection) {
+				withContextT(t, defaultTestTimeout, func(ctx context.Context, t testing.TB) {
+					name := fmt.Sprintf("test-user-%s", uuid.New().String())
+
+					u, err := client.CreateUser(ctx, name, nil)
+					require.NoError(t, err)
+					defer client.RemoveUser(ctx, name)
+
+					require.NoError(t, u.SetDatabaseAccess(ctx, db.Name(), arangodb.GrantReadOnly))
+
+					grant, err := u.GetDatabaseAccess(ctx, db.Name())
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantReadOnly, grant)
+
+					dbs, err := u.AccessibleDatabases(ctx)
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantReadOnly, dbs[db.Name()])
+
+					require.NoError(t, u.SetCollectionAccess(ctx, db.Name(), col.Name(), arangodb.GrantReadWrite))
+
+					grant, err = u.GetCollectionAccess(ctx, db.Name(), col.Name())
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantReadWrite, grant)
+
+					full, err := u.AccessibleDatabasesFull(ctx)
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantReadOnly, full[db.Name()].Permission)
+					require.Equal(t, arangodb.GrantReadWrite, full[db.Name()].Collections[col.Name()])
+
+					require.NoError(t, u.SetCollectionAccess(ctx, db.Name(), arangodb.GrantWildcard, arangodb.GrantNone))
+
+					grant, err = u.GetCollectionAccess(ctx, db.Name(), arangodb.GrantWildcard)
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantNone, grant)
+
+					require.NoError(t, u.RemoveCollectionAccess(ctx, db.Name(), col.Name()))
+					require.NoError(t, u.RemoveCollectionAccess(ctx, db.Name(), arangodb.GrantWildcard))
+
+					require.NoError(t, u.SetDatabaseAccess(ctx, arangodb.GrantWildcard, arangodb.GrantNone))
+					require.NoError(t, u.RemoveDatabaseAccess(ctx, db.Name()))
+
+					grant, err = u.GetDatabaseAccess(ctx, db.Name())
+					require.NoError(t, err)
+					require.Equal(t, arangodb.GrantNone, grant)
+
+					require.NoError(t, u.RemoveDatabaseAccess(ctx, arangodb.GrantWildcard))
+				})
+			})
+		})
+	})
+}
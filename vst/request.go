@@ -134,6 +134,11 @@ func (r *vstRequest) Written() bool {
 	return r.written
 }
 
+// GetBody returns the encoded body, which is sent to the server.
+func (r *vstRequest) GetBody() []byte {
+	return r.bodyBuilder.GetBody()
+}
+
 // WroteRequest sets written to true.
 func (r *vstRequest) WroteRequest() {
 	r.written = true